@@ -2,6 +2,7 @@ package cmccloudv2
 
 import (
 	"log"
+	"time"
 
 	gocmcapi "github.com/cmc-cloud/gocmcapiv2"
 )
@@ -12,11 +13,20 @@ type Config struct {
 	APIEndpoint string
 	ProjectId   string
 	RegionId    string
+
+	MaxRetries      int
+	RetryMinBackoff time.Duration
+	RetryMaxBackoff time.Duration
+
+	DefaultTags []string
 }
 
 // CombinedConfig struct
 type CombinedConfig struct {
 	client *gocmcapi.Client
+	retry  retryConfig
+
+	defaultTags []string
 }
 
 func (c *CombinedConfig) goCMCClient() *gocmcapi.Client { return c.client }
@@ -36,5 +46,11 @@ func (c *Config) Client() (*CombinedConfig, error) {
 	}
 	return &CombinedConfig{
 		client: client,
+		retry: retryConfig{
+			MaxRetries: c.MaxRetries,
+			MinBackoff: c.RetryMinBackoff,
+			MaxBackoff: c.RetryMaxBackoff,
+		},
+		defaultTags: c.DefaultTags,
 	}, nil
 }
@@ -0,0 +1,347 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// cluster v1 (magnum) va v2 (cloudops-core) la 2 he thong khac nhau, backend khong ho tro chuyen doi tai cho
+// nen khong the import cluster v1 vao state cua cmccloudv2_kubernetesv2. Data source nay chi sinh cau hinh
+// cmccloudv2_kubernetesv2 + cmccloudv2_kubernetesv2_nodegroup tuong duong tu cluster v1 de tao cluster v2 song song,
+// chuyen workload roi moi xoa cluster v1
+func datasourceKubernetesMigrationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateUUID,
+			Description:  "Id of the legacy cmccloudv2_kubernetes (v1) cluster",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateNameK8s,
+			Description:  "Name of the new kubernetesv2 cluster, default is name of the v1 cluster with suffix -v2 because both clusters run side by side",
+		},
+		"cidr_block_pod": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateIPCidrRange,
+			Description:  "Pod cidr of the new cluster, default is taken from calico_ipv4pool/flannel_network_cidr label of the v1 cluster",
+		},
+		"cidr_block_service": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateIPCidrRange,
+			Description:  "Service cidr of the new cluster, default is taken from service_cluster_ip_range label of the v1 cluster",
+		},
+		"autoscale_max_ram_gb": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Used when autoscale is enabled on the v1 cluster, default is total ram of masters and max_node of each node group",
+		},
+		"autoscale_max_core": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Used when autoscale is enabled on the v1 cluster, default is total cores of masters and max_node of each node group",
+		},
+		"max_unhealthy_percent": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: validation.IntBetween(1, 100),
+			Description:  "Used for node groups when autohealing is enabled on the v1 cluster",
+		},
+		"node_startup_timeout_minutes": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 100),
+			Description:  "Used for node groups when autohealing is enabled on the v1 cluster",
+		},
+		"kubernetesv2": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Attributes of cmccloudv2_kubernetesv2 equivalent to the v1 cluster",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name":                 {Type: schema.TypeString, Computed: true},
+					"zone":                 {Type: schema.TypeString, Computed: true},
+					"subnet_id":            {Type: schema.TypeString, Computed: true},
+					"kubernetes_version":   {Type: schema.TypeString, Computed: true},
+					"master_count":         {Type: schema.TypeInt, Computed: true},
+					"master_flavor_name":   {Type: schema.TypeString, Computed: true},
+					"cidr_block_pod":       {Type: schema.TypeString, Computed: true},
+					"cidr_block_service":   {Type: schema.TypeString, Computed: true},
+					"network_driver":       {Type: schema.TypeString, Computed: true},
+					"enable_autohealing":   {Type: schema.TypeBool, Computed: true},
+					"enable_autoscale":     {Type: schema.TypeBool, Computed: true},
+					"autoscale_max_node":   {Type: schema.TypeInt, Computed: true},
+					"autoscale_max_ram_gb": {Type: schema.TypeInt, Computed: true},
+					"autoscale_max_core":   {Type: schema.TypeInt, Computed: true},
+				},
+			},
+		},
+		"nodegroups": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Attributes of cmccloudv2_kubernetesv2_nodegroup equivalent to each worker node group of the v1 cluster",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name":                         {Type: schema.TypeString, Computed: true},
+					"zone":                         {Type: schema.TypeString, Computed: true},
+					"flavor_id":                    {Type: schema.TypeString, Computed: true},
+					"key_name":                     {Type: schema.TypeString, Computed: true},
+					"volume_type":                  {Type: schema.TypeString, Computed: true},
+					"volume_size":                  {Type: schema.TypeInt, Computed: true},
+					"enable_autoscale":             {Type: schema.TypeBool, Computed: true},
+					"min_node":                     {Type: schema.TypeInt, Computed: true},
+					"max_node":                     {Type: schema.TypeInt, Computed: true},
+					"enable_autohealing":           {Type: schema.TypeBool, Computed: true},
+					"max_unhealthy_percent":        {Type: schema.TypeInt, Computed: true},
+					"node_startup_timeout_minutes": {Type: schema.TypeInt, Computed: true},
+				},
+			},
+		},
+		"config": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Generated terraform configuration of the kubernetesv2 cluster and node groups",
+		},
+	}
+}
+
+func datasourceKubernetesMigration() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceKubernetesMigrationRead,
+		Schema: datasourceKubernetesMigrationSchema(),
+	}
+}
+
+func dataSourceKubernetesMigrationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	clusterId := d.Get("cluster_id").(string)
+	cluster, labels, err := getKubernetesMigrationSourceCluster(meta, clusterId)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve kubernetes cluster [%s]: %s", clusterId, err)
+	}
+	log.Printf("[DEBUG] Retrieved kubernetes cluster %s: %#v", cluster.ID, cluster)
+
+	// v2 dung ten flavor cho master, v1 luu id
+	flavors := map[string]gocmcapiv2.Flavor{}
+	getFlavor := func(id string) (gocmcapiv2.Flavor, error) {
+		if flavor, ok := flavors[id]; ok {
+			return flavor, nil
+		}
+		flavor, err := client.Flavor.Get(id)
+		if err != nil {
+			return flavor, fmt.Errorf("unable to retrieve flavor [%s] of kubernetes cluster [%s]: %s", id, clusterId, err)
+		}
+		flavors[id] = flavor
+		return flavor, nil
+	}
+	masterFlavor, err := getFlavor(cluster.MasterFlavorID)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	if name == "" {
+		name = cluster.Name + "-v2"
+	}
+	networkDriver := cluster.Labels.NetworkDriver
+	if networkDriver == "" {
+		networkDriver = "calico"
+	}
+	cidrBlockPod := d.Get("cidr_block_pod").(string)
+	if cidrBlockPod == "" {
+		cidrBlockPod = labels["calico_ipv4pool"]
+		if networkDriver == "flannel" {
+			cidrBlockPod = labels["flannel_network_cidr"]
+		}
+	}
+	if cidrBlockPod == "" {
+		return fmt.Errorf("unable to determine pod cidr of kubernetes cluster [%s] from its labels, please set `cidr_block_pod`", clusterId)
+	}
+	cidrBlockService := d.Get("cidr_block_service").(string)
+	if cidrBlockService == "" {
+		cidrBlockService = labels["service_cluster_ip_range"]
+	}
+	if cidrBlockService == "" {
+		return fmt.Errorf("unable to determine service cidr of kubernetes cluster [%s] from its labels, please set `cidr_block_service`", clusterId)
+	}
+
+	nodegroups, err := client.Kubernetes.GetNodeGroups(clusterId, false)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve node groups of kubernetes cluster [%s]: %s", clusterId, err)
+	}
+	enableAutoscale := bool(cluster.Labels.AutoScalingEnabled)
+	enableAutohealing := bool(cluster.Labels.AutoHealingEnabled)
+	// tong tai nguyen toi da khi autoscale, tinh ca master vi autoscaler gioi han tren toan bo node cua cluster
+	maxNode := 0
+	maxRamMb := cluster.MasterCount * masterFlavor.RAM
+	maxCore := cluster.MasterCount * masterFlavor.Vcpus
+	nodegroupAttrs := make([]map[string]interface{}, 0, len(nodegroups))
+	for _, nodegroup := range nodegroups {
+		if nodegroup.Role == "master" {
+			continue
+		}
+		zone := nodegroup.Labels.AvailabilityZone
+		if zone == "" {
+			zone = cluster.Labels.AvailabilityZone
+		}
+		volumeType := nodegroup.Labels.DockerVolumeType
+		if volumeType == "" {
+			volumeType = cluster.Labels.DockerVolumeType
+		}
+		minNode, maxNodeOfGroup := nodegroup.NodeCount, nodegroup.NodeCount
+		nodegroupAutoscale := enableAutoscale && nodegroup.MaxNodeCount > nodegroup.MinNodeCount
+		if nodegroupAutoscale {
+			minNode, maxNodeOfGroup = nodegroup.MinNodeCount, nodegroup.MaxNodeCount
+		}
+		attrs := map[string]interface{}{
+			"name":               nodegroup.Name,
+			"zone":               zone,
+			"flavor_id":          nodegroup.FlavorID,
+			"key_name":           cluster.Keypair,
+			"volume_type":        volumeType,
+			"volume_size":        nodegroup.DockerVolumeSize,
+			"enable_autoscale":   nodegroupAutoscale,
+			"min_node":           minNode,
+			"max_node":           maxNodeOfGroup,
+			"enable_autohealing": enableAutohealing,
+		}
+		if enableAutohealing {
+			attrs["max_unhealthy_percent"] = d.Get("max_unhealthy_percent").(int)
+			attrs["node_startup_timeout_minutes"] = d.Get("node_startup_timeout_minutes").(int)
+		}
+		nodegroupAttrs = append(nodegroupAttrs, attrs)
+
+		if enableAutoscale {
+			flavor, err := getFlavor(nodegroup.FlavorID)
+			if err != nil {
+				return err
+			}
+			maxNode += maxNodeOfGroup
+			maxRamMb += maxNodeOfGroup * flavor.RAM
+			maxCore += maxNodeOfGroup * flavor.Vcpus
+		}
+	}
+
+	clusterAttrs := map[string]interface{}{
+		"name":               name,
+		"zone":               cluster.Labels.AvailabilityZone,
+		"subnet_id":          cluster.SubnetID,
+		"kubernetes_version": cluster.Labels.KubeTag,
+		"master_count":       cluster.MasterCount,
+		"master_flavor_name": masterFlavor.Name,
+		"cidr_block_pod":     cidrBlockPod,
+		"cidr_block_service": cidrBlockService,
+		"network_driver":     networkDriver,
+		"enable_autohealing": enableAutohealing,
+		"enable_autoscale":   enableAutoscale,
+	}
+	if enableAutoscale {
+		if v := int(cluster.Labels.MaxNodeCount); v > 0 {
+			maxNode = v
+		}
+		maxRamGb := (maxRamMb + 1023) / 1024
+		if v, ok := d.GetOk("autoscale_max_ram_gb"); ok {
+			maxRamGb = v.(int)
+		}
+		if v, ok := d.GetOk("autoscale_max_core"); ok {
+			maxCore = v.(int)
+		}
+		clusterAttrs["autoscale_max_node"] = maxNode
+		clusterAttrs["autoscale_max_ram_gb"] = maxRamGb
+		clusterAttrs["autoscale_max_core"] = maxCore
+	}
+
+	d.SetId(cluster.ID)
+	_ = d.Set("kubernetesv2", []map[string]interface{}{clusterAttrs})
+	_ = d.Set("nodegroups", nodegroupAttrs)
+	return d.Set("config", renderKubernetesMigrationConfig(cluster, clusterAttrs, nodegroupAttrs))
+}
+
+func renderKubernetesMigrationConfig(cluster gocmcapiv2.Kubernetes, clusterAttrs map[string]interface{}, nodegroupAttrs []map[string]interface{}) string {
+	var b strings.Builder
+	clusterLabel := terraformIdentifier(clusterAttrs["name"].(string))
+	fmt.Fprintf(&b, "# migrated from cmccloudv2_kubernetes %s (%s)\n", cluster.Name, cluster.ID)
+	fmt.Fprintf(&b, "resource \"cmccloudv2_kubernetesv2\" %q {\n", clusterLabel)
+	for _, key := range []string{"name", "zone", "subnet_id", "kubernetes_version", "master_count", "master_flavor_name", "cidr_block_pod", "cidr_block_service", "network_driver", "enable_autohealing", "enable_autoscale"} {
+		writeHclAttribute(&b, "  ", key, clusterAttrs[key])
+	}
+	if clusterAttrs["enable_autoscale"].(bool) {
+		for _, key := range []string{"autoscale_max_node", "autoscale_max_ram_gb", "autoscale_max_core"} {
+			writeHclAttribute(&b, "  ", key, clusterAttrs[key])
+		}
+	}
+	b.WriteString("}\n")
+
+	for _, nodegroup := range nodegroupAttrs {
+		fmt.Fprintf(&b, "\nresource \"cmccloudv2_kubernetesv2_nodegroup\" %q {\n", clusterLabel+"_"+terraformIdentifier(nodegroup["name"].(string)))
+		fmt.Fprintf(&b, "  cluster_id = cmccloudv2_kubernetesv2.%s.id\n", clusterLabel)
+		for _, key := range []string{"name", "zone", "flavor_id", "key_name", "volume_type", "volume_size", "enable_autoscale", "min_node", "max_node", "enable_autohealing"} {
+			writeHclAttribute(&b, "  ", key, nodegroup[key])
+		}
+		if nodegroup["enable_autohealing"].(bool) {
+			for _, key := range []string{"max_unhealthy_percent", "node_startup_timeout_minutes"} {
+				writeHclAttribute(&b, "  ", key, nodegroup[key])
+			}
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+func writeHclAttribute(b *strings.Builder, indent string, key string, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		fmt.Fprintf(b, "%s%s = %q\n", indent, key, v)
+	default:
+		fmt.Fprintf(b, "%s%s = %v\n", indent, key, v)
+	}
+}
+
+// ten resource trong terraform chi gom chu, so, _ va -, khong bat dau bang so
+func terraformIdentifier(name string) string {
+	id := regexp.MustCompile(`[^a-zA-Z0-9_-]`).ReplaceAllString(name, "_")
+	if id == "" || (id[0] >= '0' && id[0] <= '9') {
+		id = "k8s_" + id
+	}
+	return id
+}
+
+// gocmcapiv2 chi parse mot so label cua cluster v1, lay them map labels day du tu cung response
+func getKubernetesMigrationSourceCluster(meta interface{}, id string) (gocmcapiv2.Kubernetes, map[string]string, error) {
+	var cluster gocmcapiv2.Kubernetes
+	var raw struct {
+		Labels map[string]interface{} `json:"labels"`
+	}
+	jsonStr, err := getClient(meta).Get("kubernetes/cluster/"+id, map[string]string{})
+	if err != nil {
+		return cluster, nil, err
+	}
+	if err = json.Unmarshal([]byte(jsonStr), &cluster); err != nil {
+		return cluster, nil, err
+	}
+	if err = json.Unmarshal([]byte(jsonStr), &raw); err != nil {
+		return cluster, nil, err
+	}
+	labels := make(map[string]string, len(raw.Labels))
+	for k, v := range raw.Labels {
+		if str, ok := v.(string); ok {
+			labels[k] = str
+		}
+	}
+	return cluster, labels, nil
+}
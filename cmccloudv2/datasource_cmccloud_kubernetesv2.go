@@ -0,0 +1,138 @@
+package cmccloudv2
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func datasourceKubernetesv2Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Id of the kubernetes cluster",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Filter by name of cluster, match exactly (case-insensitive)",
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"kubernetes_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"master_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"worker_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"subnet_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vpc_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"security_group_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cidr_block_pod": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cidr_block_service": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"network_driver": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kubernetes API server endpoint",
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func datasourceKubernetesv2() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceKubernetesv2Read,
+		Schema: datasourceKubernetesv2Schema(),
+	}
+}
+
+func dataSourceKubernetesv2Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+
+	clusterId := d.Get("cluster_id").(string)
+	if clusterId == "" {
+		clusters, err := client.Kubernetesv2.List(map[string]string{})
+		if err != nil {
+			return fmt.Errorf("error when get kubernetes clusters %v", err)
+		}
+		var filteredClusters []gocmcapiv2.Kubernetesv2ListItem
+		for _, cluster := range clusters {
+			if v := d.Get("name").(string); v != "" {
+				if !strings.EqualFold(cluster.ClusterName, v) {
+					continue
+				}
+			}
+			filteredClusters = append(filteredClusters, cluster)
+		}
+		if len(filteredClusters) < 1 {
+			return fmt.Errorf("your query returned no results. Please change your search criteria and try again")
+		}
+		if len(filteredClusters) > 1 {
+			gocmcapiv2.Logo("[DEBUG] Multiple results found: %#v", filteredClusters)
+			return fmt.Errorf("your query returned more than one result. Please try a more specific search criteria")
+		}
+		clusterId = filteredClusters[0].ClusterID
+	}
+
+	cluster, err := client.Kubernetesv2.Get(clusterId)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve kubernetes cluster [%s]: %s", clusterId, err)
+	}
+	return dataSourceComputeKubernetesv2Attributes(d, cluster)
+}
+
+func dataSourceComputeKubernetesv2Attributes(d *schema.ResourceData, cluster gocmcapiv2.Kubernetesv2) error {
+	log.Printf("[DEBUG] Retrieved kubernetes cluster %s: %#v", cluster.ClusterID, cluster)
+	d.SetId(cluster.ClusterID)
+	return errors.Join(
+		d.Set("cluster_id", cluster.ClusterID),
+		d.Set("name", cluster.ClusterName),
+		d.Set("state", cluster.State),
+		d.Set("kubernetes_version", cluster.KubeletVersion),
+		d.Set("master_count", cluster.NumberMasterNode),
+		d.Set("worker_count", cluster.NumberWorkerNode),
+		d.Set("subnet_id", cluster.SubnetID),
+		d.Set("vpc_id", cluster.VpcID),
+		d.Set("security_group_id", cluster.SecurityGroupID),
+		d.Set("cidr_block_pod", cluster.CidrBlockPod),
+		d.Set("cidr_block_service", cluster.CidrBlockService),
+		d.Set("network_driver", cluster.ServiceDomain),
+		d.Set("host", cluster.MasterURL),
+		d.Set("created_at", cluster.CreatedAt),
+	)
+}
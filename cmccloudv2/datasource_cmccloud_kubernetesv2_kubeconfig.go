@@ -0,0 +1,72 @@
+package cmccloudv2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func datasourceKubernetesv2KubeconfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Id of the kubernetes cluster",
+		},
+		"host": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"kubeconfig_raw": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"cluster_ca_certificate": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"client_certificate": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"client_key": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"token": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func datasourceKubernetesv2Kubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceKubernetesv2KubeconfigRead,
+		Schema: datasourceKubernetesv2KubeconfigSchema(),
+	}
+}
+
+func dataSourceKubernetesv2KubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	clusterId := d.Get("cluster_id").(string)
+	kubernetes, err := client.Kubernetesv2.Get(clusterId)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve kubernetes cluster [%s]: %s", clusterId, err)
+	}
+	kubeconfig, err := getKubernetesv2Kubeconfig(meta, clusterId)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve kubeconfig of kubernetes cluster [%s]: %s", clusterId, err)
+	}
+	if kubeconfig.Host == "" {
+		kubeconfig.Host = kubernetes.MasterURL
+	}
+	d.SetId(clusterId)
+	setKubernetesv2Kubeconfig(d, kubeconfig)
+	return nil
+}
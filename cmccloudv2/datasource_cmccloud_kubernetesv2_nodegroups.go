@@ -0,0 +1,168 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func datasourceKubernetesv2NodeGroupsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateUUID,
+			Description:  "Id of the kubernetes cluster",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Filter by name of node group, match exactly (case-insensitive)",
+			Optional:    true,
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Description:  "Filter by name of node group using a regular expression",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"nodegroups": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"node_count": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"flavor_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"image": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"key_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"enable_autoscale": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"min_node": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"max_node": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"enable_autohealing": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceKubernetesv2NodeGroups() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceKubernetesv2NodeGroupsRead,
+		Schema: datasourceKubernetesv2NodeGroupsSchema(),
+	}
+}
+
+func dataSourceKubernetesv2NodeGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	clusterId := d.Get("cluster_id").(string)
+	nodegroups, err := client.Kubernetesv2.GetNodeGroups(clusterId, false)
+	if err != nil {
+		return fmt.Errorf("error when get node groups of kubernetes cluster [%s]: %v", clusterId, err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex = regexp.MustCompile(v)
+	}
+
+	var filteredNodeGroups []gocmcapiv2.Kubernetesv2NodeGroup
+	for _, nodegroup := range nodegroups {
+		if v := d.Get("name").(string); v != "" {
+			if !strings.EqualFold(nodegroup.Name, v) {
+				continue
+			}
+		}
+		if nameRegex != nil && !nameRegex.MatchString(nodegroup.Name) {
+			continue
+		}
+		filteredNodeGroups = append(filteredNodeGroups, nodegroup)
+	}
+
+	sort.Slice(filteredNodeGroups, func(i, j int) bool {
+		return filteredNodeGroups[i].Name < filteredNodeGroups[j].Name
+	})
+
+	ids := make([]string, len(filteredNodeGroups))
+	results := make([]map[string]interface{}, len(filteredNodeGroups))
+	for i, nodegroup := range filteredNodeGroups {
+		log.Printf("[DEBUG] Retrieved node group %s: %#v", nodegroup.ID, nodegroup)
+		ids[i] = nodegroup.ID
+		result := map[string]interface{}{
+			"id":                 nodegroup.ID,
+			"name":               nodegroup.Name,
+			"status":             nodegroup.Status,
+			"node_count":         nodegroup.NodeCount,
+			"flavor_name":        nodegroup.MetadataMachineDeployment.FlavorName,
+			"image":              nodegroup.MetadataMachineDeployment.Image,
+			"key_name":           nodegroup.KeyName,
+			"enable_autoscale":   false,
+			"min_node":           nodegroup.NodeCount,
+			"max_node":           nodegroup.NodeCount,
+			"enable_autohealing": false,
+		}
+		// giong resourceKubernetesv2NodeGroupRead, thong tin autoscale/autohealing nam trong externalProviders
+		for _, provider := range nodegroup.ExternalProviders {
+			if strings.Contains(provider.Name, "auto-scale") {
+				result["enable_autoscale"] = provider.Status == "active" && provider.Config.MaxNode > provider.Config.MinNode
+				result["min_node"] = provider.Config.MinNode
+				result["max_node"] = provider.Config.MaxNode
+			}
+			if strings.Contains(provider.Name, "auto-healing") {
+				result["enable_autohealing"] = provider.Status == "active"
+			}
+		}
+		results[i] = result
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(clusterId + ":" + strings.Join(ids, ","))))
+	_ = d.Set("ids", ids)
+	return d.Set("nodegroups", results)
+}
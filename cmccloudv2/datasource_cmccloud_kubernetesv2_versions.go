@@ -0,0 +1,114 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func datasourceKubernetesv2VersionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"version_prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return versions start with this prefix, eg: 1.28",
+		},
+		"versions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Supported kubernetes versions, sorted from oldest to newest",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"latest_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"master_flavor_names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Flavors can be used as master_flavor_name of cmccloudv2_kubernetesv2",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func datasourceKubernetesv2Versions() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceKubernetesv2VersionsRead,
+		Schema: datasourceKubernetesv2VersionsSchema(),
+	}
+}
+
+func dataSourceKubernetesv2VersionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	allVersions, err := getKubernetesv2Versions(meta)
+	if err != nil {
+		return fmt.Errorf("error when get kubernetes versions %v", err)
+	}
+
+	prefix := strings.TrimPrefix(d.Get("version_prefix").(string), "v")
+	versions := make([]string, 0, len(allVersions))
+	for _, version := range allVersions {
+		if prefix != "" && !strings.HasPrefix(strings.TrimPrefix(version, "v"), prefix) {
+			continue
+		}
+		// bo qua version khong parse duoc de sort ben duoi khong gap loi
+		if _, err := parseKubernetesVersion(version); err != nil {
+			log.Printf("[WARN] skip kubernetes version %q: %v", version, err)
+			continue
+		}
+		versions = append(versions, version)
+	}
+	if len(versions) < 1 {
+		return fmt.Errorf("your query returned no results. Please change your search criteria and try again")
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		cmp, _ := compareKubernetesVersion(versions[i], versions[j])
+		return cmp < 0
+	})
+
+	flavors, err := client.Flavor.List(map[string]string{})
+	if err != nil {
+		return fmt.Errorf("error when get flavors %v", err)
+	}
+	flavorNames := make([]string, 0)
+	for _, flavor := range flavors {
+		if flavor.ExtraSpecs.IsK8sFlavor {
+			flavorNames = append(flavorNames, flavor.Name)
+		}
+	}
+	sort.Strings(flavorNames)
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(versions, ","))))
+	_ = d.Set("versions", versions)
+	_ = d.Set("latest_version", versions[len(versions)-1])
+	return d.Set("master_flavor_names", flavorNames)
+}
+
+// gocmcapiv2 chua ho tro lay danh sach version, goi truc tiep cloudops-core api
+func getKubernetesv2Versions(meta interface{}) ([]string, error) {
+	jsonStr, err := getClient(meta).Get("cloudops-core/api/v1/k8s/versions", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	var obj struct {
+		Data []struct {
+			Version string `json:"version"`
+		} `json:"data"`
+	}
+	err = json.Unmarshal([]byte(jsonStr), &obj)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]string, 0, len(obj.Data))
+	for _, item := range obj.Data {
+		versions = append(versions, item.Version)
+	}
+	return versions, nil
+}
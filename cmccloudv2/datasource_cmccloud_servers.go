@@ -0,0 +1,239 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func datasourceServersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Filter by name of server, match exactly (case-insensitive)",
+			Optional:    true,
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Description:  "Filter by name of server using a regular expression",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"status": {
+			Type:         schema.TypeString,
+			Description:  "filter by server status (case-insensitive)",
+			ValidateFunc: validation.StringInSlice([]string{"active", "shutoff", "error", "suspended", "build", "reboot", "rebuild", "resize", "resized", "paused", "shelved", "rescue", "revert_resize", "verify_resize"}, true),
+			Optional:     true,
+		},
+		"vm_state": {
+			Type:         schema.TypeString,
+			Description:  "filter by vm_state (case-insensitive)",
+			ValidateFunc: validation.StringInSlice([]string{"active", "stopped", "error", "building", "resized", "rescued", "paused", "suspended", "shelved"}, true),
+			Optional:     true,
+		},
+		"zone": {
+			Type:        schema.TypeString,
+			Description: "filter by server zone that contains this text (case-insensitive)",
+			Optional:    true,
+		},
+		"tags": {
+			Type:        schema.TypeSet,
+			Description: "Only return servers that have all of these tags",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"servers": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"vm_state": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"zone": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"flavor_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"flavor_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"cpu": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"ram": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"key_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"ip_addresses": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"tags": {
+						Type:     schema.TypeSet,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"created_at": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceServers() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceServersRead,
+		Schema: datasourceServersSchema(),
+	}
+}
+
+func dataSourceServersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+
+	params := map[string]string{
+		"name":     d.Get("name").(string),
+		"status":   d.Get("status").(string),
+		"vm_state": d.Get("vm_state").(string),
+		"zone":     d.Get("zone").(string),
+	}
+	servers, err := client.Server.List(params)
+	if err != nil {
+		return fmt.Errorf("error when get servers %v", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex = regexp.MustCompile(v)
+	}
+	tags := getStringArrayFromTypeSet(d.Get("tags").(*schema.Set))
+
+	var filteredServers []gocmcapiv2.Server
+	for _, server := range servers {
+		if v := d.Get("name").(string); v != "" {
+			if !strings.EqualFold(server.Name, v) {
+				continue
+			}
+		}
+		if nameRegex != nil && !nameRegex.MatchString(server.Name) {
+			continue
+		}
+		if v := d.Get("status").(string); v != "" {
+			if !strings.Contains(strings.ToLower(server.Status), strings.ToLower(v)) {
+				continue
+			}
+		}
+		if v := d.Get("vm_state").(string); v != "" {
+			if !strings.Contains(strings.ToLower(server.VMState), strings.ToLower(v)) {
+				continue
+			}
+		}
+		if v := d.Get("zone").(string); v != "" {
+			if !caseInsensitiveContains(server.AvailabilityZone, v) {
+				continue
+			}
+		}
+		hasAllTags := true
+		for _, tag := range tags {
+			if !arrayContains(server.Tags, tag) {
+				hasAllTags = false
+				break
+			}
+		}
+		if !hasAllTags {
+			continue
+		}
+		filteredServers = append(filteredServers, server)
+	}
+
+	sort.Slice(filteredServers, func(i, j int) bool {
+		return filteredServers[i].Name < filteredServers[j].Name
+	})
+
+	ids := make([]string, len(filteredServers))
+	results := make([]map[string]interface{}, len(filteredServers))
+	for i, server := range filteredServers {
+		log.Printf("[DEBUG] Retrieved server %s: %#v", server.ID, server)
+		ids[i] = server.ID
+		results[i] = map[string]interface{}{
+			"id":           server.ID,
+			"name":         server.Name,
+			"status":       strings.ToLower(server.Status),
+			"vm_state":     strings.ToLower(server.VMState),
+			"zone":         server.AvailabilityZone,
+			"flavor_id":    server.Flavor.ID,
+			"flavor_name":  server.Flavor.OriginalName,
+			"cpu":          server.Flavor.CPU,
+			"ram":          server.Flavor.RAM / 1024,
+			"key_name":     server.KeyName,
+			"ip_addresses": getServerIpAddresses(server),
+			"tags":         server.Tags,
+			"created_at":   server.Created,
+		}
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+	_ = d.Set("ids", ids)
+	return d.Set("servers", results)
+}
+
+// lay danh sach ip cua server tu truong addresses dang {"network": [{"addr": "..."}]}
+func getServerIpAddresses(server gocmcapiv2.Server) []string {
+	ips := make([]string, 0)
+	if m, ok := server.Addresses.(map[string]interface{}); ok {
+		for _, value := range m {
+			inters, ok := value.([]interface{})
+			if !ok {
+				continue
+			}
+			for _, inter := range inters {
+				if intermap, ok := inter.(map[string]interface{}); ok {
+					if ip, ok := intermap["addr"].(string); ok {
+						ips = append(ips, ip)
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(ips)
+	return ips
+}
@@ -0,0 +1,1847 @@
+package cmccloudv2
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+const (
+	fakeAPIKey    = "fake-api-key"
+	fakeProjectID = "fake-project-id"
+	fakeRegionID  = "hn-1"
+)
+
+// fakeObject la 1 resource luu trong fake api, key la ten truong json ma gocmcapiv2 doc ra
+type fakeObject map[string]interface{}
+
+// fakeStep la 1 buoc chuyen trang thai async, duoc ap dung sau moi lan GET resource.
+// remove = true thi resource bi xoa han, GET tiep theo tra ve 404
+type fakeStep struct {
+	set    fakeObject
+	remove bool
+}
+
+type fakeHandler func(f *fakeCMCCloudAPI, vars []string, body fakeObject) (int, interface{})
+
+type fakeRoute struct {
+	method  string
+	pattern string
+	handle  fakeHandler
+}
+
+// fakeFailure la loi tra ve 1 lan cho request khop method & pattern, dung de gia lap api bi rate limit/qua tai
+type fakeFailure struct {
+	method  string
+	pattern string
+	status  int
+	message string
+}
+
+// fakeCMCCloudAPI gia lap cac api cua CMC Cloud ma gocmcapiv2 goi toi, resource duoc luu trong bo nho.
+// Trang thai async (server building -> active, ELB PENDING_* -> ACTIVE, volume creating -> available...)
+// chuyen dan qua tung lan GET nen cac ham wait cua provider chay dung nhu voi api that
+type fakeCMCCloudAPI struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu       sync.Mutex
+	seq      int
+	objects  map[string]map[string]fakeObject // kind => id => object
+	steps    map[string][]fakeStep            // id => cac buoc chuyen trang thai con lai
+	requests []string                         // "METHOD path" cua cac request da nhan
+	failures []fakeFailure
+}
+
+func newFakeCMCCloudAPI(t *testing.T) *fakeCMCCloudAPI {
+	f := &fakeCMCCloudAPI{
+		t:       t,
+		objects: make(map[string]map[string]fakeObject),
+		steps:   make(map[string][]fakeStep),
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// providerConfig tra ve provider block tro api_endpoint toi fake api
+func (f *fakeCMCCloudAPI) providerConfig() string {
+	return fmt.Sprintf(`
+provider "cmccloudv2" {
+  api_endpoint = %q
+  api_key      = %q
+  project_id   = %q
+  region_id    = %q
+}
+`, f.server.URL, fakeAPIKey, fakeProjectID, fakeRegionID)
+}
+
+func (f *fakeCMCCloudAPI) providers() map[string]terraform.ResourceProvider {
+	return map[string]terraform.ResourceProvider{
+		"cmccloudv2": Provider(),
+	}
+}
+
+// meta tra ve client tro toi fake api, dung khi test truc tiep cac ham nhan meta cua provider
+func (f *fakeCMCCloudAPI) meta() *CombinedConfig {
+	meta, err := (&Config{
+		APIKey:      fakeAPIKey,
+		APIEndpoint: f.server.URL,
+		ProjectId:   fakeProjectID,
+		RegionId:    fakeRegionID,
+	}).Client()
+	if err != nil {
+		f.t.Fatalf("fake api: create client: %v", err)
+	}
+	return meta
+}
+
+var fakeRoutes = []fakeRoute{
+	{"PUT", "billing/update_billing_mode", (*fakeCMCCloudAPI).updateBillingMode},
+
+	{"POST", "network/vpc", (*fakeCMCCloudAPI).createVPC},
+	{"POST", "network/vpc/{id}/subnet", (*fakeCMCCloudAPI).createSubnet},
+	{"GET", "network/vpc/{id}", fakeGet("vpc")},
+	{"PUT", "network/vpc/{id}", fakeUpdate("vpc", "name", "description", "tags")},
+	{"DELETE", "network/vpc/{id}", (*fakeCMCCloudAPI).deleteVPC},
+
+	{"GET", "network/subnet/{id}", fakeGet("subnet")},
+	{"PUT", "network/subnet/{id}", fakeUpdate("subnet", "name", "enable_dhcp", "gateway_ip", "allocation_pools", "host_routes", "dns_nameservers", "tags")},
+	{"DELETE", "network/subnet/{id}", fakeDelete("subnet", nil)},
+
+	{"POST", "network/securitygroup", (*fakeCMCCloudAPI).createSecurityGroup},
+	{"DELETE", "network/securitygroup/rule/{id}", (*fakeCMCCloudAPI).deleteSecurityGroupRule},
+	{"POST", "network/securitygroup/{id}/rule", (*fakeCMCCloudAPI).createSecurityGroupRule},
+	{"GET", "network/securitygroup/{id}", fakeGet("securitygroup")},
+	{"PUT", "network/securitygroup/{id}", fakeUpdate("securitygroup", "name", "description", "stateful")},
+	{"DELETE", "network/securitygroup/{id}", fakeDelete("securitygroup", nil)},
+
+	{"POST", "volume", (*fakeCMCCloudAPI).createVolume},
+	{"POST", "volume/{id}/resize", (*fakeCMCCloudAPI).resizeVolume},
+	{"GET", "volume/{id}", fakeGet("volume")},
+	{"PUT", "volume/{id}", fakeUpdate("volume", "name", "description", "tags")},
+	{"DELETE", "volume/{id}", (*fakeCMCCloudAPI).deleteVolume},
+
+	{"POST", "server", (*fakeCMCCloudAPI).createServer},
+	{"PUT", "server/{id}/tags", fakeUpdate("server", "tags")},
+	{"POST", "server/{id}/{action}", (*fakeCMCCloudAPI).serverAction},
+	{"GET", "server/{id}", fakeGet("server")},
+	{"PUT", "server/{id}", fakeUpdate("server", "name")},
+	{"DELETE", "server/{id}", (*fakeCMCCloudAPI).deleteServer},
+
+	{"POST", "lbaas", (*fakeCMCCloudAPI).createELB},
+	{"POST", "lbaas/healthmonitor", (*fakeCMCCloudAPI).createHealthMonitor},
+	{"GET", "lbaas/healthmonitor/{id}", fakeGet("healthmonitor")},
+	{"PUT", "lbaas/healthmonitor/{id}", (*fakeCMCCloudAPI).updateHealthMonitor},
+	{"DELETE", "lbaas/healthmonitor/{id}", (*fakeCMCCloudAPI).deleteHealthMonitor},
+	{"POST", "lbaas/l7policy", (*fakeCMCCloudAPI).createL7Policy},
+	{"GET", "lbaas/l7policy/{id}", fakeGet("l7policy")},
+	{"PUT", "lbaas/l7policy/{id}", (*fakeCMCCloudAPI).updateL7Policy},
+	{"DELETE", "lbaas/l7policy/{id}", (*fakeCMCCloudAPI).deleteL7Policy},
+	{"GET", "lbaas/listener/{id}", fakeGet("listener")},
+	{"PUT", "lbaas/listener/{id}", (*fakeCMCCloudAPI).updateListener},
+	{"DELETE", "lbaas/listener/{id}", (*fakeCMCCloudAPI).deleteListener},
+	{"POST", "lbaas/pool/{id}/member", (*fakeCMCCloudAPI).createPoolMember},
+	{"GET", "lbaas/pool/{id}/member/{id}", (*fakeCMCCloudAPI).getPoolMember},
+	{"PUT", "lbaas/pool/{id}/member/{id}", (*fakeCMCCloudAPI).updatePoolMember},
+	{"DELETE", "lbaas/pool/{id}/member/{id}", (*fakeCMCCloudAPI).deletePoolMember},
+	{"GET", "lbaas/pool/{id}", fakeGet("pool")},
+	{"PUT", "lbaas/pool/{id}", (*fakeCMCCloudAPI).updatePool},
+	{"DELETE", "lbaas/pool/{id}", (*fakeCMCCloudAPI).deletePool},
+	{"POST", "lbaas/{id}/listener", (*fakeCMCCloudAPI).createListener},
+	{"POST", "lbaas/{id}/pool", (*fakeCMCCloudAPI).createPool},
+	{"POST", "lbaas/{id}/resize", (*fakeCMCCloudAPI).resizeELB},
+	{"GET", "lbaas/{id}", fakeGet("lbaas")},
+	{"PUT", "lbaas/{id}", (*fakeCMCCloudAPI).updateELB},
+	{"DELETE", "lbaas/{id}", (*fakeCMCCloudAPI).deleteELB},
+
+	{"GET", "as/group/{id}", fakeGet("asgroup")},
+	{"GET", "as/policy/{id}", fakeGet("aspolicy")},
+	{"POST", "as/alarm", (*fakeCMCCloudAPI).createAutoScalingAlarm},
+	{"GET", "as/alarm/{id}", fakeGet("asalarm")},
+	{"PUT", "as/alarm/{id}", fakeUpdate("asalarm", autoScalingAlarmKeys...)},
+	{"DELETE", "as/alarm/{id}", fakeDelete("asalarm", nil)},
+	{"POST", "as/group/{id}/schedule", (*fakeCMCCloudAPI).createAutoScalingSchedule},
+	{"GET", "as/group/{id}/schedule/{id}", (*fakeCMCCloudAPI).getAutoScalingSchedule},
+	{"PUT", "as/group/{id}/schedule/{id}", (*fakeCMCCloudAPI).updateAutoScalingSchedule},
+	{"DELETE", "as/group/{id}/schedule/{id}", (*fakeCMCCloudAPI).deleteAutoScalingSchedule},
+	{"PUT", "as/group/{id}/capacity", (*fakeCMCCloudAPI).updateAutoScalingGroupCapacity},
+	{"POST", "as/group/{id}/del_nodes", (*fakeCMCCloudAPI).deleteAutoScalingGroupNodes},
+	{"GET", "as/group/action/{id}", fakeGet("asaction")},
+
+	{"POST", "keypair", (*fakeCMCCloudAPI).createKeypair},
+	{"GET", "keypair/{id}", fakeGet("keypair")},
+	{"DELETE", "keypair/{id}", fakeDelete("keypair", nil)},
+
+	{"POST", "image", (*fakeCMCCloudAPI).createImage},
+	{"PUT", "image/{id}/file", (*fakeCMCCloudAPI).uploadImageFile},
+	{"GET", "image/{id}/members", (*fakeCMCCloudAPI).getImageMembers},
+	{"POST", "image/{id}/members", (*fakeCMCCloudAPI).addImageMember},
+	{"DELETE", "image/{id}/members/{id}", (*fakeCMCCloudAPI).removeImageMember},
+	{"GET", "image/{id}", fakeGet("image")},
+	{"PUT", "image/{id}", fakeUpdate("image", imageKeys...)},
+	{"DELETE", "image/{id}", fakeDelete("image", fakeObject{"status": "deleted"})},
+	{"POST", "volume/{id}/upload_to_image", (*fakeCMCCloudAPI).createImageFromVolume},
+
+	{"GET", "dbaas/instance/{id}", fakeGet("dbinstance")},
+	{"GET", "dbaas/instance/{id}/database", (*fakeCMCCloudAPI).listDatabaseSchemas},
+	{"POST", "dbaas/instance/{id}/database", (*fakeCMCCloudAPI).createDatabaseSchema},
+	{"DELETE", "dbaas/instance/{id}/database/{id}", (*fakeCMCCloudAPI).deleteDatabaseSchema},
+	{"POST", "dbaas/instance/{id}/user", (*fakeCMCCloudAPI).createDatabaseUser},
+	{"GET", "dbaas/instance/{id}/user/{id}", (*fakeCMCCloudAPI).getDatabaseUser},
+	{"PUT", "dbaas/instance/{id}/user/{id}", (*fakeCMCCloudAPI).updateDatabaseUser},
+	{"DELETE", "dbaas/instance/{id}/user/{id}", (*fakeCMCCloudAPI).deleteDatabaseUser},
+	{"PUT", "dbaas/instance/{id}/user/{id}/databases", (*fakeCMCCloudAPI).grantDatabaseUserAccess},
+	{"DELETE", "dbaas/instance/{id}/user/{id}/databases/{id}", (*fakeCMCCloudAPI).revokeDatabaseUserAccess},
+	{"POST", "dbaas/instance", (*fakeCMCCloudAPI).createDatabaseReplica},
+	{"PUT", "dbaas/instance/{id}", fakeUpdate("dbinstance", "name")},
+	{"DELETE", "dbaas/instance/{id}", (*fakeCMCCloudAPI).deleteDatabaseInstance},
+	{"POST", "dbaas/instance/{id}/resize", (*fakeCMCCloudAPI).resizeDatabaseInstance},
+	{"POST", "dbaas/instance/{id}/resize_volume", (*fakeCMCCloudAPI).resizeDatabaseInstanceVolume},
+	{"POST", "dbaas/instance/{id}/detach_replica", (*fakeCMCCloudAPI).detachDatabaseReplica},
+
+	{"POST", "cloudops-core/api/v1/bbc-keys/containers", (*fakeCMCCloudAPI).createKeyManagementContainer},
+	{"GET", "cloudops-core/api/v1/bbc-keys/containers/{id}", (*fakeCMCCloudAPI).getKeyManagementContainer},
+	{"DELETE", "cloudops-core/api/v1/bbc-keys/containers/{id}", (*fakeCMCCloudAPI).deleteKeyManagementContainer},
+	{"POST", "cloudops-core/api/v1/bbc-keys/secrets", (*fakeCMCCloudAPI).createKeyManagementSecrets},
+	{"DELETE", "cloudops-core/api/v1/bbc-keys/secrets/{id}", (*fakeCMCCloudAPI).deleteKeyManagementSecret},
+}
+
+func (f *fakeCMCCloudAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// giu nguyen path da escape, id co the chua '/' da escape (vd database user <name>@<host>)
+	path := strings.Trim(r.URL.EscapedPath(), "/")
+	f.requests = append(f.requests, r.Method+" "+path)
+
+	status, res := f.route(r, path)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		f.t.Errorf("fake api: encode response of %s %s: %v", r.Method, path, err)
+	}
+}
+
+func (f *fakeCMCCloudAPI) route(r *http.Request, path string) (int, interface{}) {
+	if r.URL.Query().Get("api_key") != fakeAPIKey || r.Header.Get("Project-Id") != fakeProjectID || r.Header.Get("Region-Id") != fakeRegionID {
+		return fakeError(http.StatusUnauthorized, "invalid api_key, project or region")
+	}
+	body := fakeObject{}
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fakeError(http.StatusBadRequest, err.Error())
+	}
+	if len(raw) > 0 && r.Header.Get("Content-Type") == "application/octet-stream" {
+		// du lieu upload (vd image file) khong phai json, handler doc tu body["data"]
+		body["data"] = string(raw)
+	} else if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &body); err != nil {
+			return fakeError(http.StatusBadRequest, "invalid json body: "+err.Error())
+		}
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segments[i], err = url.PathUnescape(segment); err != nil {
+			return fakeError(http.StatusBadRequest, "invalid path: "+err.Error())
+		}
+	}
+	for i, failure := range f.failures {
+		if _, ok := matchFakePattern(failure.pattern, segments); ok && failure.method == r.Method {
+			f.failures = append(f.failures[:i], f.failures[i+1:]...)
+			return fakeError(failure.status, failure.message)
+		}
+	}
+	for _, route := range fakeRoutes {
+		if route.method != r.Method {
+			continue
+		}
+		if vars, ok := matchFakePattern(route.pattern, segments); ok {
+			return route.handle(f, vars, body)
+		}
+	}
+	// request chua duoc gia lap thi bao loi test, khong tra ve 404 de provider khong hieu nham la resource da bi xoa
+	f.t.Errorf("fake api: unsupported request %s %s", r.Method, path)
+	return fakeError(http.StatusNotImplemented, "unsupported request "+r.Method+" "+path)
+}
+
+// matchFakePattern so khop path voi pattern dang a/{id}/b, tra ve cac gia tri cua {...} theo thu tu
+func matchFakePattern(pattern string, segments []string) ([]string, bool) {
+	parts := strings.Split(pattern, "/")
+	if len(parts) != len(segments) {
+		return nil, false
+	}
+	var vars []string
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") {
+			vars = append(vars, segments[i])
+		} else if part != segments[i] {
+			return nil, false
+		}
+	}
+	return vars, true
+}
+
+// failNext lam request tiep theo khop method & pattern tra ve loi status, request sau do xu ly binh thuong
+func (f *fakeCMCCloudAPI) failNext(method string, pattern string, status int, message string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, fakeFailure{method, pattern, status, message})
+}
+
+func fakeError(status int, message string) (int, interface{}) {
+	return status, fakeObject{
+		"success": false,
+		"error": fakeObject{
+			"code":    status,
+			"message": message,
+		},
+	}
+}
+
+func fakeNotFound(kind string, id string) (int, interface{}) {
+	return fakeError(http.StatusNotFound, fmt.Sprintf("%s %s not found", kind, id))
+}
+
+func fakeSuccess() (int, interface{}) {
+	return http.StatusOK, fakeObject{"success": true}
+}
+
+func (f *fakeCMCCloudAPI) newID() string {
+	f.seq++
+	return fmt.Sprintf("0fa4e000-0000-4000-8000-%012d", f.seq)
+}
+
+// insert luu resource moi voi id & created_at tu sinh
+func (f *fakeCMCCloudAPI) insert(kind string, obj fakeObject) fakeObject {
+	if f.objects[kind] == nil {
+		f.objects[kind] = make(map[string]fakeObject)
+	}
+	obj["id"] = f.newID()
+	obj["created_at"] = time.Now().UTC().Format(time.RFC3339)
+	f.objects[kind][obj["id"].(string)] = obj
+	return obj
+}
+
+func (f *fakeCMCCloudAPI) find(kind string, id string) fakeObject {
+	return f.objects[kind][id]
+}
+
+// transition set ngay cac gia tri trong now, cac gia tri trong next duoc set lan luot sau moi lan GET
+func (f *fakeCMCCloudAPI) transition(obj fakeObject, now fakeObject, next ...fakeObject) {
+	for k, v := range now {
+		obj[k] = v
+	}
+	steps := make([]fakeStep, len(next))
+	for i, set := range next {
+		steps[i] = fakeStep{set: set}
+	}
+	f.steps[obj["id"].(string)] = steps
+}
+
+// remove danh dau resource dang bi xoa (set now), GET tiep theo van thay resource, sau do moi tra ve 404
+func (f *fakeCMCCloudAPI) remove(obj fakeObject, now fakeObject) {
+	f.transition(obj, now)
+	id := obj["id"].(string)
+	f.steps[id] = append(f.steps[id], fakeStep{remove: true})
+}
+
+// advance ap dung buoc chuyen trang thai tiep theo cua resource
+func (f *fakeCMCCloudAPI) advance(kind string, obj fakeObject) {
+	id := obj["id"].(string)
+	steps := f.steps[id]
+	if len(steps) == 0 {
+		return
+	}
+	f.steps[id] = steps[1:]
+	if steps[0].remove {
+		delete(f.objects[kind], id)
+		delete(f.steps, id)
+		return
+	}
+	for k, v := range steps[0].set {
+		obj[k] = v
+	}
+}
+
+// snapshot copy resource ra json truoc khi chuyen trang thai, tranh tra ve gia tri da bi sua
+func snapshot(obj fakeObject) json.RawMessage {
+	raw, _ := json.Marshal(obj)
+	return raw
+}
+
+func fakeGet(kind string) fakeHandler {
+	return func(f *fakeCMCCloudAPI, vars []string, _ fakeObject) (int, interface{}) {
+		obj := f.find(kind, vars[0])
+		if obj == nil {
+			return fakeNotFound(kind, vars[0])
+		}
+		res := snapshot(obj)
+		f.advance(kind, obj)
+		return http.StatusOK, res
+	}
+}
+
+func fakeUpdate(kind string, keys ...string) fakeHandler {
+	return func(f *fakeCMCCloudAPI, vars []string, body fakeObject) (int, interface{}) {
+		obj := f.find(kind, vars[0])
+		if obj == nil {
+			return fakeNotFound(kind, vars[0])
+		}
+		patch(obj, body, keys...)
+		return fakeSuccess()
+	}
+}
+
+func fakeDelete(kind string, now fakeObject) fakeHandler {
+	return func(f *fakeCMCCloudAPI, vars []string, _ fakeObject) (int, interface{}) {
+		obj := f.find(kind, vars[0])
+		if obj == nil {
+			return fakeNotFound(kind, vars[0])
+		}
+		f.remove(obj, now)
+		return fakeSuccess()
+	}
+}
+
+// pick copy cac truong trong keys tu request body
+func pick(body fakeObject, keys ...string) fakeObject {
+	obj := fakeObject{}
+	patch(obj, body, keys...)
+	return obj
+}
+
+func patch(obj fakeObject, body fakeObject, keys ...string) {
+	for _, k := range keys {
+		if v, ok := body[k]; ok {
+			obj[k] = v
+		}
+	}
+}
+
+func appendRef(obj fakeObject, key string, id string) {
+	refs, _ := obj[key].([]interface{})
+	obj[key] = append(refs, fakeObject{"id": id})
+}
+
+func removeRef(obj fakeObject, key string, id string) {
+	refs, _ := obj[key].([]interface{})
+	kept := make([]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		if ref.(fakeObject)["id"] != id {
+			kept = append(kept, ref)
+		}
+	}
+	obj[key] = kept
+}
+
+// fakeNullIfEmpty tra ve nil cho gia tri rong, api tra ve null thay vi chuoi rong
+func fakeNullIfEmpty(v interface{}) interface{} {
+	if v == nil || v == "" {
+		return nil
+	}
+	return v
+}
+
+func fakeInt(v interface{}) int {
+	n, _ := v.(float64)
+	return int(n)
+}
+
+// fakeHostIP tra ve ip thu n trong cidr cua subnet
+func fakeHostIP(cidr string, n int) string {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil || network.IP.To4() == nil {
+		return fmt.Sprintf("10.0.0.%d", n%256)
+	}
+	ip := network.IP.To4()
+	return net.IPv4(ip[0], ip[1], ip[2], ip[3]+byte(n%256)).String()
+}
+
+// allocateIP cap ip moi trong subnet cho server/ELB, bo qua cac ip dau dai (gateway...)
+func (f *fakeCMCCloudAPI) allocateIP(cidr string) string {
+	f.seq++
+	return fakeHostIP(cidr, 10+f.seq%200)
+}
+
+func (f *fakeCMCCloudAPI) updateBillingMode(_ []string, body fakeObject) (int, interface{}) {
+	id, _ := body["resource_id"].(string)
+	for _, objects := range f.objects {
+		if obj, ok := objects[id]; ok {
+			obj["billing_mode"] = body["billing_mode"]
+			return fakeSuccess()
+		}
+	}
+	return fakeNotFound(fmt.Sprint(body["resource_type"]), id)
+}
+
+func (f *fakeCMCCloudAPI) createVPC(_ []string, body fakeObject) (int, interface{}) {
+	vpc := f.insert("vpc", pick(body, "name", "description", "cidr", "billing_mode", "tags"))
+	vpc["project_id"] = fakeProjectID
+	vpc["router_id"] = f.newID()
+	return http.StatusOK, vpc
+}
+
+func (f *fakeCMCCloudAPI) deleteVPC(vars []string, _ fakeObject) (int, interface{}) {
+	vpc := f.find("vpc", vars[0])
+	if vpc == nil {
+		return fakeNotFound("vpc", vars[0])
+	}
+	for _, subnet := range f.objects["subnet"] {
+		if subnet["vpc_id"] == vars[0] {
+			return fakeError(http.StatusConflict, "vpc still has subnet "+subnet["id"].(string))
+		}
+	}
+	f.remove(vpc, nil)
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) createSubnet(vars []string, body fakeObject) (int, interface{}) {
+	if f.find("vpc", vars[0]) == nil {
+		return fakeNotFound("vpc", vars[0])
+	}
+	subnet := f.insert("subnet", pick(body, "name", "ip_version", "enable_dhcp", "gateway_ip", "cidr", "allocation_pools", "host_routes", "dns_nameservers", "tags"))
+	subnet["vpc_id"] = vars[0]
+	subnet["network_id"] = vars[0]
+	return http.StatusOK, subnet
+}
+
+// seedSubnet tao san vpc & subnet ngoai terraform, dung cho resource can subnet_id biet truoc khi plan
+func (f *fakeCMCCloudAPI) seedSubnet(cidr string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	vpc := f.insert("vpc", fakeObject{"name": "seed-vpc", "cidr": cidr, "billing_mode": "monthly", "tags": []interface{}{}})
+	subnet := f.insert("subnet", fakeObject{"name": "seed-subnet", "ip_version": 4, "cidr": cidr, "gateway_ip": fakeHostIP(cidr, 1), "tags": []interface{}{}})
+	subnet["vpc_id"] = vpc["id"]
+	subnet["network_id"] = vpc["id"]
+	return subnet["id"].(string)
+}
+
+func (f *fakeCMCCloudAPI) newSecurityGroupRule(body fakeObject) fakeObject {
+	return fakeObject{
+		"id":               f.newID(),
+		"ethertype":        body["ether_type"],
+		"direction":        body["direction"],
+		"protocol":         body["protocol"],
+		"port_range_min":   body["port_range_min"],
+		"port_range_max":   body["port_range_max"],
+		"remote_ip_prefix": fakeNullIfEmpty(body["cidr"]),
+		"remote_group_id":  fakeNullIfEmpty(body["dest_securitygroup_id"]),
+		"description":      body["description"],
+		"created_at":       time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+func (f *fakeCMCCloudAPI) createSecurityGroup(_ []string, body fakeObject) (int, interface{}) {
+	group := f.insert("securitygroup", pick(body, "name", "description", "stateful"))
+	// api luon tao san rule egress mac dinh, resource phai tu xoa neu quan ly rule
+	group["security_group_rules"] = []interface{}{
+		f.newSecurityGroupRule(fakeObject{"ether_type": "IPv4", "direction": "egress", "protocol": ""}),
+		f.newSecurityGroupRule(fakeObject{"ether_type": "IPv6", "direction": "egress", "protocol": ""}),
+	}
+	return http.StatusOK, group
+}
+
+func (f *fakeCMCCloudAPI) createSecurityGroupRule(vars []string, body fakeObject) (int, interface{}) {
+	group := f.find("securitygroup", vars[0])
+	if group == nil {
+		return fakeNotFound("securitygroup", vars[0])
+	}
+	rule := f.newSecurityGroupRule(body)
+	group["security_group_rules"] = append(group["security_group_rules"].([]interface{}), rule)
+	return http.StatusOK, rule
+}
+
+func (f *fakeCMCCloudAPI) deleteSecurityGroupRule(vars []string, _ fakeObject) (int, interface{}) {
+	for _, group := range f.objects["securitygroup"] {
+		rules := group["security_group_rules"].([]interface{})
+		for i, rule := range rules {
+			if rule.(fakeObject)["id"] == vars[0] {
+				group["security_group_rules"] = append(rules[:i:i], rules[i+1:]...)
+				return fakeSuccess()
+			}
+		}
+	}
+	return fakeNotFound("securitygroup rule", vars[0])
+}
+
+func (f *fakeCMCCloudAPI) newVolume(body fakeObject, bootable bool) fakeObject {
+	vol := f.insert("volume", pick(body, "name", "description", "size", "billing_mode", "tags"))
+	vol["volume_type"] = body["type"]
+	vol["availability_zone"] = body["zone_name"]
+	vol["bootable"] = fmt.Sprint(bootable)
+	vol["attachments"] = []interface{}{}
+	return vol
+}
+
+func (f *fakeCMCCloudAPI) createVolume(_ []string, body fakeObject) (int, interface{}) {
+	vol := f.newVolume(body, false)
+	f.transition(vol, fakeObject{"status": "creating"}, fakeObject{"status": "available"})
+	return http.StatusOK, vol
+}
+
+func (f *fakeCMCCloudAPI) resizeVolume(vars []string, body fakeObject) (int, interface{}) {
+	vol := f.find("volume", vars[0])
+	if vol == nil {
+		return fakeNotFound("volume", vars[0])
+	}
+	if fakeInt(body["size"]) <= fakeInt(vol["size"]) {
+		return fakeError(http.StatusBadRequest, "new size of volume must be greater than current size")
+	}
+	status := vol["status"]
+	f.transition(vol, fakeObject{"size": body["size"], "status": "extending"}, fakeObject{"status": status})
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) deleteVolume(vars []string, _ fakeObject) (int, interface{}) {
+	vol := f.find("volume", vars[0])
+	if vol == nil {
+		return fakeNotFound("volume", vars[0])
+	}
+	if vol["status"] == "in-use" {
+		return fakeError(http.StatusBadRequest, "volume "+vars[0]+" is attached to a server")
+	}
+	f.remove(vol, fakeObject{"status": "deleting"})
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) createServer(_ []string, body fakeObject) (int, interface{}) {
+	var nics []interface{}
+	for _, raw := range body["subnets"].([]interface{}) {
+		nic := raw.(map[string]interface{})
+		subnetID, _ := nic["subnet_id"].(string)
+		subnet := f.find("subnet", subnetID)
+		if subnet == nil {
+			return fakeError(http.StatusBadRequest, "subnet "+subnetID+" not found")
+		}
+		ip, _ := nic["ip_address"].(string)
+		if ip == "" {
+			ip = f.allocateIP(subnet["cidr"].(string))
+		}
+		securityGroups, _ := nic["security_group_ids"].([]interface{})
+		if securityGroups == nil {
+			securityGroups = []interface{}{}
+		}
+		nics = append(nics, fakeObject{
+			"id":              f.newID(),
+			"net_id":          subnet["network_id"],
+			"mac_addr":        fmt.Sprintf("fa:16:3e:00:00:%02x", f.seq%256),
+			"fixed_ips":       []interface{}{fakeObject{"subnet_id": subnetID, "ip_address": ip}},
+			"security_groups": securityGroups,
+		})
+	}
+
+	var attached []interface{}
+	for i, raw := range body["volumes"].([]interface{}) {
+		v := raw.(map[string]interface{})
+		vol := f.newVolume(fakeObject{"size": v["size"], "type": v["type"], "zone_name": body["zone"], "billing_mode": body["billing_mode"]}, i == 0)
+		vol["status"] = "in-use"
+		deleteOnTermination, ok := v["delete_on_termination"].(bool)
+		if !ok {
+			deleteOnTermination = true
+		}
+		attached = append(attached, fakeObject{"id": vol["id"], "delete_on_termination": deleteOnTermination})
+	}
+
+	var securityGroups []interface{}
+	for _, name := range body["security_group_names"].([]interface{}) {
+		securityGroups = append(securityGroups, fakeObject{"name": name})
+	}
+	serverGroups := []interface{}{}
+	if id, _ := body["ecs_group_id"].(string); id != "" {
+		serverGroups = append(serverGroups, id)
+	}
+
+	server := f.insert("server", pick(body, "key_name", "billing_mode", "tags"))
+	server["name"] = body["server_name"]
+	server["created"] = server["created_at"]
+	server["OS-EXT-AZ:availability_zone"] = body["zone"]
+	server["flavor"] = fakeObject{"id": body["flavor_id"]}
+	server["security_groups"] = securityGroups
+	server["server_groups"] = serverGroups
+	server["nics"] = nics
+	server["os-extended-volumes:volumes_attached"] = attached
+	f.transition(server,
+		fakeObject{"status": "BUILD", "OS-EXT-STS:vm_state": "building", "OS-EXT-STS:task_state": "spawning"},
+		fakeObject{"status": "ACTIVE", "OS-EXT-STS:vm_state": "active", "OS-EXT-STS:task_state": nil})
+	return http.StatusOK, fakeObject{
+		"success": true,
+		"server":  fakeObject{"id": server["id"], "adminPass": "fake-password"},
+	}
+}
+
+func (f *fakeCMCCloudAPI) serverAction(vars []string, body fakeObject) (int, interface{}) {
+	server := f.find("server", vars[0])
+	if server == nil {
+		return fakeNotFound("server", vars[0])
+	}
+	vmState := server["OS-EXT-STS:vm_state"]
+	requireState := func(state string) (int, interface{}) {
+		return fakeError(http.StatusConflict, fmt.Sprintf("cannot %s server %s in vm_state %v, vm_state must be %s", vars[1], vars[0], vmState, state))
+	}
+
+	switch vars[1] {
+	case "resize":
+		if vmState != "active" {
+			return requireState("active")
+		}
+		f.transition(server,
+			fakeObject{"status": "RESIZE", "OS-EXT-STS:task_state": "resize_migrating"},
+			fakeObject{"status": "VERIFY_RESIZE", "OS-EXT-STS:vm_state": "resized", "OS-EXT-STS:task_state": nil, "flavor": fakeObject{"id": body["flavor_id"]}})
+	case "confirm_resize":
+		if vmState != "resized" {
+			return requireState("resized")
+		}
+		f.transition(server,
+			fakeObject{"OS-EXT-STS:task_state": "resize_confirming"},
+			fakeObject{"status": "ACTIVE", "OS-EXT-STS:vm_state": "active", "OS-EXT-STS:task_state": nil})
+	case "stop":
+		if vmState != "active" {
+			return requireState("active")
+		}
+		f.transition(server,
+			fakeObject{"OS-EXT-STS:task_state": "powering-off"},
+			fakeObject{"status": "SHUTOFF", "OS-EXT-STS:vm_state": "stopped", "OS-EXT-STS:task_state": nil})
+	case "start":
+		if vmState != "stopped" {
+			return requireState("stopped")
+		}
+		f.transition(server,
+			fakeObject{"OS-EXT-STS:task_state": "powering-on"},
+			fakeObject{"status": "ACTIVE", "OS-EXT-STS:vm_state": "active", "OS-EXT-STS:task_state": nil})
+	case "rebuild":
+		if body["image_id"] == nil {
+			return fakeError(http.StatusBadRequest, "image_id is required")
+		}
+		// vm_state khong doi trong luc rebuild, chi status & task_state thay doi
+		status := server["status"]
+		f.transition(server,
+			fakeObject{"status": "REBUILD", "OS-EXT-STS:task_state": "rebuilding"},
+			fakeObject{"OS-EXT-STS:task_state": "rebuild_spawning"},
+			fakeObject{"status": status, "OS-EXT-STS:task_state": nil})
+	case "attach_security_group":
+		removeSecurityGroupName(server, body["security_group_name"])
+		server["security_groups"] = append(server["security_groups"].([]interface{}), fakeObject{"name": body["security_group_name"]})
+	case "detach_security_group":
+		removeSecurityGroupName(server, body["security_group_name"])
+	case "change_pass":
+	default:
+		f.t.Errorf("fake api: unsupported server action %s", vars[1])
+		return fakeError(http.StatusNotImplemented, "unsupported server action "+vars[1])
+	}
+	return fakeSuccess()
+}
+
+func removeSecurityGroupName(server fakeObject, name interface{}) {
+	groups, _ := server["security_groups"].([]interface{})
+	kept := make([]interface{}, 0, len(groups))
+	for _, group := range groups {
+		if group.(fakeObject)["name"] != name {
+			kept = append(kept, group)
+		}
+	}
+	server["security_groups"] = kept
+}
+
+func (f *fakeCMCCloudAPI) deleteServer(vars []string, _ fakeObject) (int, interface{}) {
+	server := f.find("server", vars[0])
+	if server == nil {
+		return fakeNotFound("server", vars[0])
+	}
+	// volume co delete_on_termination bi xoa ngay cung server, cac volume khac duoc detach
+	for _, raw := range server["os-extended-volumes:volumes_attached"].([]interface{}) {
+		attach := raw.(fakeObject)
+		vol := f.find("volume", attach["id"].(string))
+		if vol == nil {
+			continue
+		}
+		if attach["delete_on_termination"] == true {
+			delete(f.objects["volume"], vol["id"].(string))
+			delete(f.steps, vol["id"].(string))
+		} else {
+			vol["status"] = "available"
+		}
+	}
+	f.remove(server, fakeObject{"OS-EXT-STS:task_state": "deleting"})
+	return fakeSuccess()
+}
+
+// markELBUpdating chuyen ELB sang PENDING_UPDATE khi thay doi listener/pool/member..., giong octavia
+func (f *fakeCMCCloudAPI) markELBUpdating(elbID string) {
+	if elb := f.find("lbaas", elbID); elb != nil {
+		f.transition(elb, fakeObject{"provisioning_status": "PENDING_UPDATE"}, fakeObject{"provisioning_status": "ACTIVE"})
+	}
+}
+
+// markUpdating chuyen resource con cua ELB sang PENDING_UPDATE roi ACTIVE
+func (f *fakeCMCCloudAPI) markUpdating(obj fakeObject, elbID string) {
+	f.transition(obj, fakeObject{"provisioning_status": "PENDING_UPDATE"}, fakeObject{"provisioning_status": "ACTIVE"})
+	f.markELBUpdating(elbID)
+}
+
+func (f *fakeCMCCloudAPI) createELB(_ []string, body fakeObject) (int, interface{}) {
+	elb := f.insert("lbaas", pick(body, "name", "description", "flavor_id", "billing_mode", "tags"))
+	elb["availability_zone"] = body["zone"]
+	elb["listeners"] = []interface{}{}
+	elb["pools"] = []interface{}{}
+	if body["network_type"] == "private" {
+		subnetID, _ := body["subnet_id"].(string)
+		subnet := f.find("subnet", subnetID)
+		if subnet == nil {
+			return fakeError(http.StatusBadRequest, "subnet "+subnetID+" not found")
+		}
+		elb["vip_subnet_id"] = subnetID
+		elb["vip_network_id"] = subnet["network_id"]
+		elb["vip_address"] = f.allocateIP(subnet["cidr"].(string))
+	} else {
+		elb["vip_address"] = fmt.Sprintf("103.21.148.%d", 10+f.seq%200)
+		elb["domestic_bandwidth_mbps"] = body["bandwidth_mbps"]
+	}
+	f.transition(elb,
+		fakeObject{"provisioning_status": "PENDING_CREATE", "operating_status": "OFFLINE"},
+		fakeObject{"provisioning_status": "ACTIVE", "operating_status": "ONLINE"})
+	return http.StatusOK, elb
+}
+
+func (f *fakeCMCCloudAPI) updateELB(vars []string, body fakeObject) (int, interface{}) {
+	elb := f.find("lbaas", vars[0])
+	if elb == nil {
+		return fakeNotFound("lbaas", vars[0])
+	}
+	patch(elb, body, "name", "description", "tags")
+	f.markELBUpdating(vars[0])
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) resizeELB(vars []string, body fakeObject) (int, interface{}) {
+	elb := f.find("lbaas", vars[0])
+	if elb == nil {
+		return fakeNotFound("lbaas", vars[0])
+	}
+	done := fakeObject{"provisioning_status": "ACTIVE"}
+	if v, ok := body["flavor_id"]; ok {
+		done["flavor_id"] = v
+	}
+	if v, ok := body["bandwidth_mbps"]; ok {
+		done["domestic_bandwidth_mbps"] = v
+	}
+	f.transition(elb, fakeObject{"provisioning_status": "PENDING_UPDATE"}, done)
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) deleteELB(vars []string, _ fakeObject) (int, interface{}) {
+	elb := f.find("lbaas", vars[0])
+	if elb == nil {
+		return fakeNotFound("lbaas", vars[0])
+	}
+	if len(elb["listeners"].([]interface{})) > 0 || len(elb["pools"].([]interface{})) > 0 {
+		return fakeError(http.StatusConflict, "load balancer "+vars[0]+" still has listeners or pools")
+	}
+	f.remove(elb, fakeObject{"provisioning_status": "PENDING_DELETE"})
+	return fakeSuccess()
+}
+
+// insertHeaders tra ve insert_headers giong octavia: object chuoi "true"/"false" voi HTTP, mang rong voi cac protocol khac
+func insertHeaders(protocol interface{}, body fakeObject) interface{} {
+	if protocol != "HTTP" && protocol != "TERMINATED_HTTPS" {
+		return []interface{}{}
+	}
+	return fakeObject{
+		"X-Forwarded-For":   fmt.Sprint(body["x_forwarded_for"] == true),
+		"X-Forwarded-Port":  fmt.Sprint(body["x_forwarded_port"] == true),
+		"X-Forwarded-Proto": fmt.Sprint(body["x_forwarded_proto"] == true),
+	}
+}
+
+// setListenerDefaultPool cap nhat listeners cua pool khi default_pool_id cua listener thay doi
+func (f *fakeCMCCloudAPI) setListenerDefaultPool(listener fakeObject, poolID interface{}) error {
+	if old := f.find("pool", fmt.Sprint(listener["default_pool_id"])); old != nil {
+		removeRef(old, "listeners", listener["id"].(string))
+	}
+	listener["default_pool_id"] = poolID
+	if id, _ := poolID.(string); id != "" {
+		pool := f.find("pool", id)
+		if pool == nil {
+			return fmt.Errorf("pool %s not found", id)
+		}
+		appendRef(pool, "listeners", listener["id"].(string))
+	}
+	return nil
+}
+
+func (f *fakeCMCCloudAPI) createListener(vars []string, body fakeObject) (int, interface{}) {
+	elb := f.find("lbaas", vars[0])
+	if elb == nil {
+		return fakeNotFound("lbaas", vars[0])
+	}
+	listener := f.insert("listener", pick(body, "name", "description", "protocol", "protocol_port", "sni_container_refs", "default_tls_container_ref", "allowed_cidrs"))
+	listener["insert_headers"] = insertHeaders(body["protocol"], body)
+	listener["loadbalancers"] = []interface{}{fakeObject{"id": vars[0]}}
+	listener["connection_limit"] = -1
+	listener["timeout_client_data"] = 50000
+	listener["timeout_member_connect"] = 5000
+	listener["timeout_member_data"] = 50000
+	listener["timeout_tcp_inspect"] = 0
+	if err := f.setListenerDefaultPool(listener, body["default_pool_id"]); err != nil {
+		return fakeError(http.StatusBadRequest, err.Error())
+	}
+	appendRef(elb, "listeners", listener["id"].(string))
+	f.transition(listener,
+		fakeObject{"provisioning_status": "PENDING_CREATE", "operating_status": "OFFLINE"},
+		fakeObject{"provisioning_status": "ACTIVE", "operating_status": "ONLINE"})
+	f.markELBUpdating(vars[0])
+	return http.StatusOK, listener
+}
+
+func (f *fakeCMCCloudAPI) updateListener(vars []string, body fakeObject) (int, interface{}) {
+	listener := f.find("listener", vars[0])
+	if listener == nil {
+		return fakeNotFound("listener", vars[0])
+	}
+	patch(listener, body, "name", "description", "sni_container_refs", "default_tls_container_ref", "allowed_cidrs")
+	listener["insert_headers"] = insertHeaders(listener["protocol"], body)
+	if err := f.setListenerDefaultPool(listener, body["default_pool_id"]); err != nil {
+		return fakeError(http.StatusBadRequest, err.Error())
+	}
+	f.markUpdating(listener, listenerELBID(listener))
+	return fakeSuccess()
+}
+
+func listenerELBID(listener fakeObject) string {
+	return listener["loadbalancers"].([]interface{})[0].(fakeObject)["id"].(string)
+}
+
+func (f *fakeCMCCloudAPI) deleteListener(vars []string, _ fakeObject) (int, interface{}) {
+	listener := f.find("listener", vars[0])
+	if listener == nil {
+		return fakeNotFound("listener", vars[0])
+	}
+	_ = f.setListenerDefaultPool(listener, nil)
+	elbID := listenerELBID(listener)
+	if elb := f.find("lbaas", elbID); elb != nil {
+		removeRef(elb, "listeners", vars[0])
+	}
+	f.remove(listener, fakeObject{"provisioning_status": "PENDING_DELETE"})
+	f.markELBUpdating(elbID)
+	return fakeSuccess()
+}
+
+// sessionPersistence tra ve session_persistence giong octavia: null khi khong bat
+func sessionPersistence(body fakeObject) interface{} {
+	if t, _ := body["session_persistence"].(string); t != "" && t != "NONE" {
+		return fakeObject{"type": t, "cookie_name": fakeNullIfEmpty(body["cookie_name"])}
+	}
+	return nil
+}
+
+func (f *fakeCMCCloudAPI) createPool(vars []string, body fakeObject) (int, interface{}) {
+	elb := f.find("lbaas", vars[0])
+	if elb == nil {
+		return fakeNotFound("lbaas", vars[0])
+	}
+	pool := f.insert("pool", pick(body, "name", "description", "protocol", "tls_enabled", "tls_ciphers", "tls_versions"))
+	pool["lb_algorithm"] = body["algorithm"]
+	pool["session_persistence"] = sessionPersistence(body)
+	pool["loadbalancers"] = []interface{}{fakeObject{"id": vars[0]}}
+	pool["listeners"] = []interface{}{}
+	pool["members"] = []interface{}{}
+	pool["healthmonitor_id"] = ""
+	appendRef(elb, "pools", pool["id"].(string))
+	f.transition(pool,
+		fakeObject{"provisioning_status": "PENDING_CREATE", "operating_status": "OFFLINE"},
+		fakeObject{"provisioning_status": "ACTIVE", "operating_status": "ONLINE"})
+	f.markELBUpdating(vars[0])
+	return http.StatusOK, pool
+}
+
+func poolELBID(pool fakeObject) string {
+	return pool["loadbalancers"].([]interface{})[0].(fakeObject)["id"].(string)
+}
+
+func (f *fakeCMCCloudAPI) updatePool(vars []string, body fakeObject) (int, interface{}) {
+	pool := f.find("pool", vars[0])
+	if pool == nil {
+		return fakeNotFound("pool", vars[0])
+	}
+	patch(pool, body, "name", "description", "tls_enabled", "tls_ciphers", "tls_versions")
+	pool["lb_algorithm"] = body["algorithm"]
+	pool["session_persistence"] = sessionPersistence(body)
+	f.markUpdating(pool, poolELBID(pool))
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) deletePool(vars []string, _ fakeObject) (int, interface{}) {
+	pool := f.find("pool", vars[0])
+	if pool == nil {
+		return fakeNotFound("pool", vars[0])
+	}
+	if len(pool["listeners"].([]interface{})) > 0 {
+		return fakeError(http.StatusConflict, "pool "+vars[0]+" is still used by a listener")
+	}
+	elbID := poolELBID(pool)
+	if elb := f.find("lbaas", elbID); elb != nil {
+		removeRef(elb, "pools", vars[0])
+	}
+	f.remove(pool, fakeObject{"provisioning_status": "PENDING_DELETE"})
+	f.markELBUpdating(elbID)
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) createPoolMember(vars []string, body fakeObject) (int, interface{}) {
+	pool := f.find("pool", vars[0])
+	if pool == nil {
+		return fakeNotFound("pool", vars[0])
+	}
+	member := f.insert("member", pick(body, "name", "address", "protocol_port", "weight", "subnet_id", "monitor_address", "monitor_port"))
+	member["pool_id"] = vars[0]
+	appendRef(pool, "members", member["id"].(string))
+	f.transition(member,
+		fakeObject{"provisioning_status": "PENDING_CREATE", "operating_status": "NO_MONITOR"},
+		fakeObject{"provisioning_status": "ACTIVE"})
+	f.markELBUpdating(poolELBID(pool))
+	return http.StatusOK, member
+}
+
+// findPoolMember chi tim thay member khi dung pool, giong api that
+func (f *fakeCMCCloudAPI) findPoolMember(vars []string) fakeObject {
+	member := f.find("member", vars[1])
+	if member == nil || member["pool_id"] != vars[0] {
+		return nil
+	}
+	return member
+}
+
+func (f *fakeCMCCloudAPI) getPoolMember(vars []string, body fakeObject) (int, interface{}) {
+	if f.findPoolMember(vars) == nil {
+		return fakeNotFound("member", vars[1])
+	}
+	return fakeGet("member")(f, vars[1:], body)
+}
+
+func (f *fakeCMCCloudAPI) updatePoolMember(vars []string, body fakeObject) (int, interface{}) {
+	member := f.findPoolMember(vars)
+	if member == nil {
+		return fakeNotFound("member", vars[1])
+	}
+	patch(member, body, "name", "weight", "subnet_id", "monitor_address", "monitor_port")
+	f.markUpdating(member, poolELBID(f.find("pool", vars[0])))
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) deletePoolMember(vars []string, _ fakeObject) (int, interface{}) {
+	member := f.findPoolMember(vars)
+	if member == nil {
+		return fakeNotFound("member", vars[1])
+	}
+	pool := f.find("pool", vars[0])
+	removeRef(pool, "members", vars[1])
+	f.remove(member, fakeObject{"provisioning_status": "PENDING_DELETE"})
+	f.markELBUpdating(poolELBID(pool))
+	return fakeSuccess()
+}
+
+var healthMonitorKeys = []string{"name", "type", "delay", "timeout", "max_retries", "max_retries_down", "domain_name", "http_method", "expected_codes", "url_path"}
+
+func (f *fakeCMCCloudAPI) createHealthMonitor(_ []string, body fakeObject) (int, interface{}) {
+	poolID, _ := body["pool_id"].(string)
+	pool := f.find("pool", poolID)
+	if pool == nil {
+		return fakeNotFound("pool", poolID)
+	}
+	if pool["healthmonitor_id"] != "" {
+		return fakeError(http.StatusConflict, "pool "+poolID+" already has a health monitor")
+	}
+	monitor := f.insert("healthmonitor", pick(body, healthMonitorKeys...))
+	monitor["pools"] = []interface{}{fakeObject{"id": poolID}}
+	pool["healthmonitor_id"] = monitor["id"]
+	f.transition(monitor,
+		fakeObject{"provisioning_status": "PENDING_CREATE", "operating_status": "OFFLINE"},
+		fakeObject{"provisioning_status": "ACTIVE", "operating_status": "ONLINE"})
+	f.markELBUpdating(poolELBID(pool))
+	return http.StatusOK, monitor
+}
+
+func healthMonitorPoolID(monitor fakeObject) string {
+	return monitor["pools"].([]interface{})[0].(fakeObject)["id"].(string)
+}
+
+func (f *fakeCMCCloudAPI) updateHealthMonitor(vars []string, body fakeObject) (int, interface{}) {
+	monitor := f.find("healthmonitor", vars[0])
+	if monitor == nil {
+		return fakeNotFound("healthmonitor", vars[0])
+	}
+	patch(monitor, body, healthMonitorKeys...)
+	f.markUpdating(monitor, poolELBID(f.find("pool", healthMonitorPoolID(monitor))))
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) deleteHealthMonitor(vars []string, _ fakeObject) (int, interface{}) {
+	monitor := f.find("healthmonitor", vars[0])
+	if monitor == nil {
+		return fakeNotFound("healthmonitor", vars[0])
+	}
+	pool := f.find("pool", healthMonitorPoolID(monitor))
+	pool["healthmonitor_id"] = ""
+	f.remove(monitor, fakeObject{"provisioning_status": "PENDING_DELETE"})
+	f.markELBUpdating(poolELBID(pool))
+	return fakeSuccess()
+}
+
+var l7PolicyKeys = []string{"name", "description", "action", "position", "redirect_pool_id", "redirect_url", "redirect_prefix", "redirect_http_code"}
+
+// normalizeL7Policy giong octavia: chi giu cac truong redirect cua action hien tai,
+// redirect_http_code mac dinh 302 voi REDIRECT_TO_URL/REDIRECT_PREFIX
+func normalizeL7Policy(policy fakeObject) {
+	action := policy["action"]
+	for key, keep := range map[string]bool{
+		"redirect_pool_id":   action == "REDIRECT_TO_POOL",
+		"redirect_url":       action == "REDIRECT_TO_URL",
+		"redirect_prefix":    action == "REDIRECT_PREFIX",
+		"redirect_http_code": action == "REDIRECT_TO_URL" || action == "REDIRECT_PREFIX",
+	} {
+		if !keep {
+			policy[key] = nil
+		}
+	}
+	if (action == "REDIRECT_TO_URL" || action == "REDIRECT_PREFIX") && policy["redirect_http_code"] == nil {
+		policy["redirect_http_code"] = 302
+	}
+}
+
+func (f *fakeCMCCloudAPI) createL7Policy(_ []string, body fakeObject) (int, interface{}) {
+	listenerID, _ := body["listener_id"].(string)
+	listener := f.find("listener", listenerID)
+	if listener == nil {
+		return fakeNotFound("listener", listenerID)
+	}
+	policy := f.insert("l7policy", pick(body, l7PolicyKeys...))
+	policy["listener_id"] = listenerID
+	if policy["position"] == nil {
+		policy["position"] = 1
+	}
+	policy["created_at"] = "2024-01-01T00:00:00"
+	normalizeL7Policy(policy)
+	f.transition(policy,
+		fakeObject{"provisioning_status": "PENDING_CREATE", "operating_status": "OFFLINE"},
+		fakeObject{"provisioning_status": "ACTIVE", "operating_status": "ONLINE"})
+	f.markELBUpdating(listenerELBID(listener))
+	return http.StatusOK, policy
+}
+
+func (f *fakeCMCCloudAPI) updateL7Policy(vars []string, body fakeObject) (int, interface{}) {
+	policy := f.find("l7policy", vars[0])
+	if policy == nil {
+		return fakeNotFound("l7policy", vars[0])
+	}
+	patch(policy, body, l7PolicyKeys...)
+	normalizeL7Policy(policy)
+	f.markUpdating(policy, listenerELBID(f.find("listener", policy["listener_id"].(string))))
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) deleteL7Policy(vars []string, _ fakeObject) (int, interface{}) {
+	policy := f.find("l7policy", vars[0])
+	if policy == nil {
+		return fakeNotFound("l7policy", vars[0])
+	}
+	f.remove(policy, fakeObject{"provisioning_status": "PENDING_DELETE"})
+	if listener := f.find("listener", policy["listener_id"].(string)); listener != nil {
+		f.markELBUpdating(listenerELBID(listener))
+	}
+	return fakeSuccess()
+}
+
+// seedAutoScalingPolicy tao san policy scale in/out voi event CLUSTER_SCALE_IN hoac CLUSTER_SCALE_OUT
+func (f *fakeCMCCloudAPI) seedAutoScalingPolicy(event string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	policy := f.insert("aspolicy", fakeObject{
+		"name": "seed-policy",
+		"spec": fakeObject{"properties": fakeObject{"event": event}},
+	})
+	return policy["id"].(string)
+}
+
+// seedAutoScalingGroup tao san autoscaling group da attach cac policy, khong tao qua terraform vi group can
+// configuration, server & node chay that
+func (f *fakeCMCCloudAPI) seedAutoScalingGroup(policyIDs ...string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	policies := make([]interface{}, 0, len(policyIDs))
+	for _, id := range policyIDs {
+		policies = append(policies, id)
+	}
+	group := f.insert("asgroup", fakeObject{
+		"name":             "seed-group",
+		"status":           "ACTIVE",
+		"min_size":         1,
+		"max_size":         3,
+		"desired_capacity": 1,
+		"nodes":            []interface{}{},
+		"policies":         policies,
+	})
+	return group["id"].(string)
+}
+
+var autoScalingAlarmKeys = []string{"name", "description", "meter", "comparison_operator", "threshold", "aggregation_method", "period", "evaluation_periods", "enabled"}
+
+func (f *fakeCMCCloudAPI) createAutoScalingAlarm(_ []string, body fakeObject) (int, interface{}) {
+	groupID, _ := body["as_group_id"].(string)
+	if f.find("asgroup", groupID) == nil {
+		return fakeNotFound("asgroup", groupID)
+	}
+	alarm := f.insert("asalarm", pick(body, append(autoScalingAlarmKeys, "as_group_id", "policy_id")...))
+	alarm["state"] = "insufficient data"
+	return http.StatusOK, alarm
+}
+
+var autoScalingScheduleKeys = []string{"name", "cron", "time_zone", "min_size", "max_size", "desired_capacity", "enabled"}
+
+func (f *fakeCMCCloudAPI) createAutoScalingSchedule(vars []string, body fakeObject) (int, interface{}) {
+	if f.find("asgroup", vars[0]) == nil {
+		return fakeNotFound("asgroup", vars[0])
+	}
+	schedule := f.insert("asschedule", pick(body, autoScalingScheduleKeys...))
+	schedule["as_group_id"] = vars[0]
+	schedule["next_run_at"] = "2024-01-02T08:00:00+07:00"
+	return http.StatusOK, schedule
+}
+
+// findAutoScalingSchedule chi tim thay schedule khi dung autoscaling group
+func (f *fakeCMCCloudAPI) findAutoScalingSchedule(vars []string) fakeObject {
+	schedule := f.find("asschedule", vars[1])
+	if schedule == nil || schedule["as_group_id"] != vars[0] {
+		return nil
+	}
+	return schedule
+}
+
+func (f *fakeCMCCloudAPI) getAutoScalingSchedule(vars []string, body fakeObject) (int, interface{}) {
+	if f.findAutoScalingSchedule(vars) == nil {
+		return fakeNotFound("asschedule", vars[1])
+	}
+	return fakeGet("asschedule")(f, vars[1:], body)
+}
+
+func (f *fakeCMCCloudAPI) updateAutoScalingSchedule(vars []string, body fakeObject) (int, interface{}) {
+	schedule := f.findAutoScalingSchedule(vars)
+	if schedule == nil {
+		return fakeNotFound("asschedule", vars[1])
+	}
+	patch(schedule, body, autoScalingScheduleKeys...)
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) deleteAutoScalingSchedule(vars []string, _ fakeObject) (int, interface{}) {
+	schedule := f.findAutoScalingSchedule(vars)
+	if schedule == nil {
+		return fakeNotFound("asschedule", vars[1])
+	}
+	f.remove(schedule, nil)
+	return fakeSuccess()
+}
+
+// seedAutoScalingGroupNodes them n node dang chay vao autoscaling group (group day, max_size = so node), tra ve id cac node
+func (f *fakeCMCCloudAPI) seedAutoScalingGroupNodes(groupID string, n int) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	group := f.find("asgroup", groupID)
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = f.newID()
+		group["nodes"] = append(group["nodes"].([]interface{}), ids[i])
+	}
+	group["desired_capacity"] = len(group["nodes"].([]interface{}))
+	group["max_size"] = group["desired_capacity"]
+	return ids
+}
+
+// newAutoScalingAction tao action RUNNING, chuyen sang SUCCEEDED sau lan GET dau tien
+func (f *fakeCMCCloudAPI) newAutoScalingAction() string {
+	action := f.insert("asaction", fakeObject{})
+	f.transition(action, fakeObject{"status": "RUNNING"}, fakeObject{"status": "SUCCEEDED"})
+	return action["id"].(string)
+}
+
+// updateAutoScalingGroupCapacity tao them node moi khi desired_capacity tang, giam thi xoa node moi nhat.
+// Moi lan update duoc luu vao capacity_updates de test kiem tra thu tu surge/tra lai capacity
+func (f *fakeCMCCloudAPI) updateAutoScalingGroupCapacity(vars []string, body fakeObject) (int, interface{}) {
+	group := f.find("asgroup", vars[0])
+	if group == nil {
+		return fakeNotFound("asgroup", vars[0])
+	}
+	capacity := pick(body, "min_size", "max_size", "desired_capacity")
+	if fakeInt(capacity["desired_capacity"]) > fakeInt(capacity["max_size"]) {
+		return fakeError(http.StatusBadRequest, "desired_capacity must be <= max_size")
+	}
+	patch(group, capacity, "min_size", "max_size", "desired_capacity")
+	updates, _ := group["capacity_updates"].([]interface{})
+	group["capacity_updates"] = append(updates, capacity)
+
+	nodes := group["nodes"].([]interface{})
+	desired := fakeInt(capacity["desired_capacity"])
+	for len(nodes) < desired {
+		nodes = append(nodes, f.newID())
+	}
+	group["nodes"] = nodes[:desired]
+	return http.StatusOK, fakeObject{"action": f.newAutoScalingAction()}
+}
+
+// deleteAutoScalingGroupNodes xoa cac node chi dinh va giam desired_capacity tuong ung
+func (f *fakeCMCCloudAPI) deleteAutoScalingGroupNodes(vars []string, body fakeObject) (int, interface{}) {
+	group := f.find("asgroup", vars[0])
+	if group == nil {
+		return fakeNotFound("asgroup", vars[0])
+	}
+	deleted, _ := body["nodes"].([]interface{})
+	nodes := group["nodes"].([]interface{})
+	for _, id := range deleted {
+		kept := make([]interface{}, 0, len(nodes))
+		for _, node := range nodes {
+			if node != id {
+				kept = append(kept, node)
+			}
+		}
+		if len(kept) == len(nodes) {
+			return fakeError(http.StatusBadRequest, fmt.Sprintf("node %v is not a member of group %s", id, vars[0]))
+		}
+		nodes = kept
+	}
+	group["nodes"] = nodes
+	group["desired_capacity"] = len(nodes)
+	return http.StatusOK, fakeObject{"action": f.newAutoScalingAction()}
+}
+
+// createKeypair luu keypair theo name (api dung name lam id), fingerprint la md5 cua public key nhu nova
+func (f *fakeCMCCloudAPI) createKeypair(_ []string, body fakeObject) (int, interface{}) {
+	name, _ := body["name"].(string)
+	if f.find("keypair", name) != nil {
+		return fakeError(http.StatusConflict, "keypair "+name+" already exists")
+	}
+	publicKey, _ := body["public_key"].(string)
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 || fields[0] != "ssh-rsa" {
+		return fakeError(http.StatusBadRequest, "invalid public_key")
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return fakeError(http.StatusBadRequest, "invalid public_key: "+err.Error())
+	}
+	sum := md5.Sum(blob)
+	fingerprint := make([]string, len(sum))
+	for i, b := range sum {
+		fingerprint[i] = fmt.Sprintf("%02x", b)
+	}
+	if f.objects["keypair"] == nil {
+		f.objects["keypair"] = make(map[string]fakeObject)
+	}
+	keypair := fakeObject{
+		"id":          name,
+		"name":        name,
+		"public_key":  publicKey,
+		"fingerprint": strings.Join(fingerprint, ":"),
+		"type":        "ssh",
+	}
+	f.objects["keypair"][name] = keypair
+	return http.StatusOK, keypair
+}
+
+// seedVolume tao san volume available, dung cho cac resource lay volume lam nguon
+func (f *fakeCMCCloudAPI) seedVolume(size int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	vol := f.newVolume(fakeObject{"name": "seed-volume", "size": size, "tags": []interface{}{}}, false)
+	vol["status"] = "available"
+	return vol["id"].(string)
+}
+
+var imageKeys = []string{"name", "visibility", "tags", "min_disk", "min_ram"}
+
+func (f *fakeCMCCloudAPI) newImage(body fakeObject) fakeObject {
+	image := f.insert("image", pick(body, append(imageKeys, "disk_format", "container_format")...))
+	defaults := fakeObject{"visibility": "private", "tags": []interface{}{}, "min_disk": 0, "min_ram": 0, "size": 0, "checksum": nil, "os": "linux", "status": "queued"}
+	for k, v := range defaults {
+		if _, ok := image[k]; !ok {
+			image[k] = v
+		}
+	}
+	image["members"] = []interface{}{}
+	return image
+}
+
+func (f *fakeCMCCloudAPI) createImage(_ []string, body fakeObject) (int, interface{}) {
+	return http.StatusOK, f.newImage(body)
+}
+
+// uploadImageFile luu size & md5 checksum cua du lieu upload, image saving roi active sau lan GET tiep theo
+func (f *fakeCMCCloudAPI) uploadImageFile(vars []string, body fakeObject) (int, interface{}) {
+	image := f.find("image", vars[0])
+	if image == nil {
+		return fakeNotFound("image", vars[0])
+	}
+	if image["status"] != "queued" {
+		return fakeError(http.StatusConflict, "image data already uploaded")
+	}
+	data, _ := body["data"].(string)
+	f.transition(image, fakeObject{"status": "saving", "size": len(data), "checksum": fmt.Sprintf("%x", md5.Sum([]byte(data)))},
+		fakeObject{"status": "active"})
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) createImageFromVolume(vars []string, body fakeObject) (int, interface{}) {
+	vol := f.find("volume", vars[0])
+	if vol == nil {
+		return fakeNotFound("volume", vars[0])
+	}
+	image := f.newImage(pick(body, "name", "disk_format"))
+	image["container_format"] = "bare"
+	f.transition(image, fakeObject{"status": "saving", "size": vol["size"].(int) << 30},
+		fakeObject{"status": "active", "checksum": fmt.Sprintf("%x", md5.Sum([]byte(vars[0])))})
+	return http.StatusOK, fakeObject{"image_id": image["id"]}
+}
+
+func (f *fakeCMCCloudAPI) getImageMembers(vars []string, _ fakeObject) (int, interface{}) {
+	image := f.find("image", vars[0])
+	if image == nil {
+		return fakeNotFound("image", vars[0])
+	}
+	members := make([]interface{}, 0)
+	for _, id := range image["members"].([]interface{}) {
+		members = append(members, fakeObject{"member_id": id, "status": "accepted"})
+	}
+	return http.StatusOK, members
+}
+
+// addImageMember chi chia se duoc image shared, giong glance
+func (f *fakeCMCCloudAPI) addImageMember(vars []string, body fakeObject) (int, interface{}) {
+	image := f.find("image", vars[0])
+	if image == nil {
+		return fakeNotFound("image", vars[0])
+	}
+	if image["visibility"] != "shared" {
+		return fakeError(http.StatusForbidden, "only shared images have members")
+	}
+	image["members"] = append(image["members"].([]interface{}), body["member_id"])
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) removeImageMember(vars []string, _ fakeObject) (int, interface{}) {
+	image := f.find("image", vars[0])
+	if image == nil {
+		return fakeNotFound("image", vars[0])
+	}
+	members := image["members"].([]interface{})
+	for i, id := range members {
+		if id == vars[1] {
+			image["members"] = append(members[:i:i], members[i+1:]...)
+			return fakeSuccess()
+		}
+	}
+	return fakeNotFound("image member", vars[1])
+}
+
+// seedDatabaseInstance tao san database instance dang BUILD, ACTIVE sau lan GET dau tien
+func (f *fakeCMCCloudAPI) seedDatabaseInstance() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	instance := f.insert("dbinstance", fakeObject{
+		"name":         "seed-db",
+		"billing_mode": "monthly",
+		"datastore":    fakeObject{"type": "mysql", "version": "8.0"},
+		"volume":       fakeObject{"size": 20},
+	})
+	f.transition(instance, fakeObject{"status": "BUILD"}, fakeObject{"status": "ACTIVE"})
+	return instance["id"].(string)
+}
+
+// editableDatabaseInstance tra ve loi neu instance khong ton tai hoac chua ACTIVE, giong trove tu choi thao tac khi instance dang ban
+func (f *fakeCMCCloudAPI) editableDatabaseInstance(id string) (int, interface{}) {
+	instance := f.find("dbinstance", id)
+	if instance == nil {
+		return fakeNotFound("dbinstance", id)
+	}
+	if instance["status"] != "ACTIVE" {
+		return fakeError(http.StatusConflict, fmt.Sprintf("instance %s is not ACTIVE, status %v", id, instance["status"]))
+	}
+	return 0, nil
+}
+
+// database schema & user cua instance luu voi key <instance_id>/<name>
+func (f *fakeCMCCloudAPI) listDatabaseSchemas(vars []string, _ fakeObject) (int, interface{}) {
+	if f.find("dbinstance", vars[0]) == nil {
+		return fakeNotFound("dbinstance", vars[0])
+	}
+	dbs := make([]interface{}, 0)
+	for _, db := range f.objects["dbschema"] {
+		if db["instance_id"] == vars[0] {
+			dbs = append(dbs, pick(db, "name", "character_set", "collate"))
+		}
+	}
+	return http.StatusOK, dbs
+}
+
+func (f *fakeCMCCloudAPI) createDatabaseSchema(vars []string, body fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	name, _ := body["name"].(string)
+	key := vars[0] + "/" + name
+	if f.find("dbschema", key) != nil {
+		return fakeError(http.StatusConflict, "database "+name+" already exists")
+	}
+	db := fakeObject{"id": key, "instance_id": vars[0], "name": name, "character_set": "utf8mb4", "collate": "utf8mb4_0900_ai_ci"}
+	patch(db, body, "character_set", "collate")
+	if f.objects["dbschema"] == nil {
+		f.objects["dbschema"] = make(map[string]fakeObject)
+	}
+	f.objects["dbschema"][key] = db
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) deleteDatabaseSchema(vars []string, _ fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	key := vars[0] + "/" + vars[1]
+	if f.find("dbschema", key) == nil {
+		return fakeNotFound("dbschema", key)
+	}
+	delete(f.objects["dbschema"], key)
+	for _, user := range f.objects["dbuser"] {
+		removeDatabaseUserAccess(user, vars[1])
+	}
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) createDatabaseUser(vars []string, body fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	user := pick(body, "name", "host", "password")
+	key := vars[0] + "/" + fmt.Sprint(user["name"]) + "@" + fmt.Sprint(user["host"])
+	if f.find("dbuser", key) != nil {
+		return fakeError(http.StatusConflict, "user "+key+" already exists")
+	}
+	user["id"] = key
+	user["databases"] = []interface{}{}
+	if status, res := f.grantDatabases(vars[0], user, body["databases"]); status != 0 {
+		return status, res
+	}
+	if f.objects["dbuser"] == nil {
+		f.objects["dbuser"] = make(map[string]fakeObject)
+	}
+	f.objects["dbuser"][key] = user
+	return fakeSuccess()
+}
+
+// grantDatabases them quyen tren cac database, database phai ton tai tren instance
+func (f *fakeCMCCloudAPI) grantDatabases(instanceID string, user fakeObject, databases interface{}) (int, interface{}) {
+	names, _ := databases.([]interface{})
+	for _, name := range names {
+		if f.find("dbschema", instanceID+"/"+fmt.Sprint(name)) == nil {
+			return fakeNotFound("dbschema", fmt.Sprint(name))
+		}
+		removeDatabaseUserAccess(user, name)
+		user["databases"] = append(user["databases"].([]interface{}), name)
+	}
+	return 0, nil
+}
+
+func removeDatabaseUserAccess(user fakeObject, database interface{}) bool {
+	databases := user["databases"].([]interface{})
+	for i, name := range databases {
+		if name == database {
+			user["databases"] = append(databases[:i:i], databases[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeCMCCloudAPI) getDatabaseUser(vars []string, _ fakeObject) (int, interface{}) {
+	user := f.find("dbuser", vars[0]+"/"+vars[1])
+	if user == nil {
+		return fakeNotFound("dbuser", vars[1])
+	}
+	databases := make([]interface{}, 0)
+	for _, name := range user["databases"].([]interface{}) {
+		databases = append(databases, fakeObject{"name": name})
+	}
+	res := fakeObject{"name": user["name"], "host": user["host"], "databases": databases}
+	f.advance("dbuser", user)
+	return http.StatusOK, res
+}
+
+func (f *fakeCMCCloudAPI) updateDatabaseUser(vars []string, body fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	user := f.find("dbuser", vars[0]+"/"+vars[1])
+	if user == nil {
+		return fakeNotFound("dbuser", vars[1])
+	}
+	patch(user, body, "password")
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) deleteDatabaseUser(vars []string, _ fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	user := f.find("dbuser", vars[0]+"/"+vars[1])
+	if user == nil {
+		return fakeNotFound("dbuser", vars[1])
+	}
+	f.remove(user, nil)
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) grantDatabaseUserAccess(vars []string, body fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	user := f.find("dbuser", vars[0]+"/"+vars[1])
+	if user == nil {
+		return fakeNotFound("dbuser", vars[1])
+	}
+	if status, res := f.grantDatabases(vars[0], user, body["databases"]); status != 0 {
+		return status, res
+	}
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) revokeDatabaseUserAccess(vars []string, _ fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	user := f.find("dbuser", vars[0]+"/"+vars[1])
+	if user == nil {
+		return fakeNotFound("dbuser", vars[1])
+	}
+	if !removeDatabaseUserAccess(user, vars[2]) {
+		return fakeNotFound("database access", vars[2])
+	}
+	return fakeSuccess()
+}
+
+// createDatabaseReplica chi gia lap tao replica (source_type = instance): tao compute server chay replica
+// voi root volume & data volume, replica BUILD roi ACTIVE sau lan GET tiep theo
+func (f *fakeCMCCloudAPI) createDatabaseReplica(_ []string, body fakeObject) (int, interface{}) {
+	if body["source_type"] != "instance" {
+		f.t.Errorf("fake api: unsupported database instance source_type %v", body["source_type"])
+		return fakeError(http.StatusNotImplemented, "only replica creation is supported")
+	}
+	primaryID, _ := body["source_id"].(string)
+	primary := f.find("dbinstance", primaryID)
+	if primary == nil {
+		return fakeNotFound("dbinstance", primaryID)
+	}
+	status, res := f.createServer(nil, fakeObject{
+		"server_name":          body["name"],
+		"zone":                 body["zone"],
+		"billing_mode":         body["billing_mode"],
+		"flavor_id":            body["flavor_id"],
+		"subnets":              body["subnets"],
+		"security_group_names": []interface{}{},
+		"volumes": []interface{}{
+			map[string]interface{}{"size": 20.0, "type": body["volume_type"]},
+			map[string]interface{}{"size": body["volume_size"], "type": body["volume_type"]},
+		},
+	})
+	if status != http.StatusOK {
+		return status, res
+	}
+	replica := f.insert("dbinstance", pick(body, "name", "billing_mode"))
+	replica["flavor"] = fakeObject{"id": body["flavor_id"]}
+	replica["volume"] = fakeObject{"size": body["volume_size"]}
+	replica["datastore"] = primary["datastore"]
+	replica["replica_of"] = fakeObject{"id": primaryID}
+	replica["compute_instance_id"] = res.(fakeObject)["server"].(fakeObject)["id"]
+	replica["created"] = replica["created_at"]
+	f.transition(replica, fakeObject{"status": "BUILD"}, fakeObject{"status": "ACTIVE"})
+	return http.StatusOK, replica
+}
+
+func (f *fakeCMCCloudAPI) resizeDatabaseInstance(vars []string, body fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	instance := f.find("dbinstance", vars[0])
+	f.transition(instance, fakeObject{"status": "RESIZE", "flavor": fakeObject{"id": body["flavor_id"]}}, fakeObject{"status": "ACTIVE"})
+	return fakeSuccess()
+}
+
+// resizeDatabaseInstanceVolume resize ca data volume cua compute server
+func (f *fakeCMCCloudAPI) resizeDatabaseInstanceVolume(vars []string, body fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	instance := f.find("dbinstance", vars[0])
+	if server := f.find("server", fmt.Sprint(instance["compute_instance_id"])); server != nil {
+		for _, raw := range server["os-extended-volumes:volumes_attached"].([]interface{}) {
+			if vol := f.find("volume", raw.(fakeObject)["id"].(string)); vol["bootable"] == "false" {
+				vol["size"] = body["size"]
+			}
+		}
+	}
+	f.transition(instance, fakeObject{"status": "RESIZE", "volume": fakeObject{"size": body["size"]}}, fakeObject{"status": "ACTIVE"})
+	return fakeSuccess()
+}
+
+// detachDatabaseReplica bien replica thanh primary, api van tra ve replica_of = null
+func (f *fakeCMCCloudAPI) detachDatabaseReplica(vars []string, _ fakeObject) (int, interface{}) {
+	if status, res := f.editableDatabaseInstance(vars[0]); status != 0 {
+		return status, res
+	}
+	instance := f.find("dbinstance", vars[0])
+	if instance["replica_of"] == nil {
+		return fakeError(http.StatusBadRequest, "instance "+vars[0]+" is not a replica")
+	}
+	f.transition(instance, fakeObject{"status": "PROMOTE", "replica_of": nil}, fakeObject{"status": "ACTIVE"})
+	return fakeSuccess()
+}
+
+// deleteDatabaseInstance xoa instance cung compute server & volume cua no
+func (f *fakeCMCCloudAPI) deleteDatabaseInstance(vars []string, _ fakeObject) (int, interface{}) {
+	instance := f.find("dbinstance", vars[0])
+	if instance == nil {
+		return fakeNotFound("dbinstance", vars[0])
+	}
+	if server := f.find("server", fmt.Sprint(instance["compute_instance_id"])); server != nil {
+		for _, raw := range server["os-extended-volumes:volumes_attached"].([]interface{}) {
+			delete(f.objects["volume"], raw.(fakeObject)["id"].(string))
+		}
+		delete(f.objects["server"], server["id"].(string))
+	}
+	f.remove(instance, fakeObject{"status": "SHUTDOWN"})
+	return fakeSuccess()
+}
+
+// key management api boc response trong "data" va dung containerUuid/secretUuid lam id
+func (f *fakeCMCCloudAPI) createKeyManagementContainer(_ []string, body fakeObject) (int, interface{}) {
+	container := f.insert("kmcontainer", fakeObject{"containerName": body["name"], "containerType": body["type"]})
+	container["containerUuid"] = container["id"]
+	container["containerRef"] = f.server.URL + "/key-manager/v1/containers/" + container["id"].(string)
+	container["created"] = container["created_at"]
+	return http.StatusOK, fakeObject{"data": pick(container, "containerUuid", "containerRef")}
+}
+
+func (f *fakeCMCCloudAPI) getKeyManagementContainer(vars []string, _ fakeObject) (int, interface{}) {
+	container := f.find("kmcontainer", vars[0])
+	if container == nil {
+		return fakeNotFound("kmcontainer", vars[0])
+	}
+	return http.StatusOK, fakeObject{"data": pick(container, "containerUuid", "containerName", "containerType", "containerRef", "created")}
+}
+
+// deleteKeyManagementContainer chi xoa duoc container da xoa het secret
+func (f *fakeCMCCloudAPI) deleteKeyManagementContainer(vars []string, _ fakeObject) (int, interface{}) {
+	if f.find("kmcontainer", vars[0]) == nil {
+		return fakeNotFound("kmcontainer", vars[0])
+	}
+	for id, secret := range f.objects["kmsecret"] {
+		if secret["containerUuid"] == vars[0] {
+			return fakeError(http.StatusConflict, "container "+vars[0]+" still has secret "+id)
+		}
+	}
+	delete(f.objects["kmcontainer"], vars[0])
+	return fakeSuccess()
+}
+
+func (f *fakeCMCCloudAPI) createKeyManagementSecrets(_ []string, body fakeObject) (int, interface{}) {
+	containerID, _ := body["containerUuid"].(string)
+	if f.find("kmcontainer", containerID) == nil {
+		return fakeNotFound("kmcontainer", containerID)
+	}
+	details, _ := body["secretDetails"].([]interface{})
+	secrets := make([]interface{}, 0, len(details))
+	for _, raw := range details {
+		secret := f.insert("kmsecret", pick(fakeObject(raw.(map[string]interface{})), "name", "secretType", "content"))
+		secret["containerUuid"] = containerID
+		secrets = append(secrets, fakeObject{"secretUuid": secret["id"]})
+	}
+	return http.StatusOK, fakeObject{"data": fakeObject{"secrets": secrets}}
+}
+
+func (f *fakeCMCCloudAPI) deleteKeyManagementSecret(vars []string, _ fakeObject) (int, interface{}) {
+	if f.find("kmsecret", vars[0]) == nil {
+		return fakeNotFound("kmsecret", vars[0])
+	}
+	delete(f.objects["kmsecret"], vars[0])
+	return fakeSuccess()
+}
+
+// testCheckRequested kiem tra fake api da nhan request khop method & pattern (vd server/{id}/confirm_resize)
+func (f *fakeCMCCloudAPI) testCheckRequested(method string, pattern string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if f.countRequests(method, pattern) == 0 {
+			return fmt.Errorf("fake api did not receive %s %s", method, pattern)
+		}
+		return nil
+	}
+}
+
+// testCheckRequestCount kiem tra fake api nhan dung n request khop method & pattern
+func (f *fakeCMCCloudAPI) testCheckRequestCount(method string, pattern string, n int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if count := f.countRequests(method, pattern); count != n {
+			return fmt.Errorf("fake api received %d %s %s, want %d", count, method, pattern, n)
+		}
+		return nil
+	}
+}
+
+func (f *fakeCMCCloudAPI) countRequests(method string, pattern string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	count := 0
+	for _, req := range f.requests {
+		parts := strings.SplitN(req, " ", 2)
+		if _, ok := matchFakePattern(pattern, strings.Split(parts[1], "/")); ok && parts[0] == method {
+			count++
+		}
+	}
+	return count
+}
+
+// testCheckObject kiem tra truong key cua moi resource thuoc kind trong fake api bang want
+func (f *fakeCMCCloudAPI) testCheckObject(kind string, key string, want interface{}) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for id, obj := range f.objects[kind] {
+			if got := obj[key]; fmt.Sprint(got) != fmt.Sprint(want) {
+				return fmt.Errorf("%s %s: %s = %v, want %v", kind, id, key, got, want)
+			}
+		}
+		return nil
+	}
+}
+
+// testCheckDestroyed kiem tra khong con resource nao thuoc cac kind trong fake api
+func (f *fakeCMCCloudAPI) testCheckDestroyed(kinds ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for _, kind := range kinds {
+			for id := range f.objects[kind] {
+				return fmt.Errorf("%s %s still exists after destroy", kind, id)
+			}
+		}
+		return nil
+	}
+}
@@ -1,7 +1,12 @@
 package cmccloudv2
 
 import (
+	"fmt"
+	"os"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
@@ -13,22 +18,76 @@ func Provider() terraform.ResourceProvider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The URL use for the CMC Cloud API",
-				DefaultFunc: schema.EnvDefaultFunc("CMC_CLOUD_API_ENDPOINT", "https://apiv2.cloud.cmctelecom.vn"),
+				DefaultFunc: schema.EnvDefaultFunc("CMC_CLOUD_API_ENDPOINT", nil),
 			},
 			"api_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
 				Description: "API key get from account settings in https://portalv2.cloud.cmctelecom.vn",
+				DefaultFunc: schema.EnvDefaultFunc("CMC_CLOUD_API_KEY", nil),
 			},
 			"project_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Description: "Id of project",
+				DefaultFunc: schema.EnvDefaultFunc("CMC_CLOUD_PROJECT_ID", nil),
 			},
 			"region_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Description: "Name of region, eg hn-1,hcm-1",
+				DefaultFunc: schema.EnvDefaultFunc("CMC_CLOUD_REGION_ID", nil),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of profile in shared config file used for api_key, project_id, region_id and api_endpoint not set in provider block",
+				DefaultFunc: schema.EnvDefaultFunc("CMC_CLOUD_PROFILE", nil),
+			},
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to shared config file in INI or YAML format, default ~/.cmccloud/config",
+				DefaultFunc: schema.EnvDefaultFunc("CMC_CLOUD_SHARED_CONFIG_FILE", nil),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of retries when the API returns 429, 502, 503, 504 or a connection error, set to 0 to disable retry. Create, update and delete are only retried on 429, 503 or when the API cannot be reached",
+			},
+			"retry_min_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Minimum time in seconds to wait before retrying a failed API call",
+			},
+			"retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum time in seconds to wait before retrying a failed API call",
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags added to every taggable resource managed by this provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
@@ -47,16 +106,25 @@ func Provider() terraform.ResourceProvider {
 			"cmccloudv2_elb_listener":                    resourceELBListener(),
 			"cmccloudv2_elb_healthmonitor":               resourceELBHealthMonitor(),
 			"cmccloudv2_elb_pool_member":                 resourceELBPoolMember(),
+			"cmccloudv2_elb_l7policy":                    resourceELBL7Policy(),
+			"cmccloudv2_elb_l7rule":                      resourceELBL7Rule(),
+			"cmccloudv2_elb_certificate":                 resourceELBCertificate(),
 			"cmccloudv2_ecs_group":                       resourceEcsGroup(),
 			"cmccloudv2_eip_port":                        resourceEIPPort(),
 			"cmccloudv2_efs":                             resourceEFS(),
 			"cmccloudv2_security_group":                  resourceSecurityGroup(),
+			"cmccloudv2_security_group_rule":             resourceSecurityGroupRule(),
+			"cmccloudv2_keypair":                         resourceKeypair(),
+			"cmccloudv2_image":                           resourceImage(),
 			"cmccloudv2_kubernetes":                      resourceKubernetes(),
 			"cmccloudv2_kubernetes_nodegroup":            resourceKubernetesNodeGroup(),
 			"cmccloudv2_kubernetesv2":                    resourceKubernetesv2(),
 			"cmccloudv2_kubernetesv2_nodegroup":          resourceKubernetesv2NodeGroup(),
 			"cmccloudv2_database_configuration":          resourceDatabaseConfiguration(),
 			"cmccloudv2_database_instance":               resourceDatabaseInstance(),
+			"cmccloudv2_database_user":                   resourceDatabaseUser(),
+			"cmccloudv2_database_schema":                 resourceDatabaseSchema(),
+			"cmccloudv2_database_replica":                resourceDatabaseReplica(),
 			"cmccloudv2_database_autobackup":             resourceDatabaseAutoBackup(),
 			"cmccloudv2_autoscaling_group":               resourceAutoScalingGroup(),
 			"cmccloudv2_autoscaling_configuration":       resourceAutoScalingConfiguration(),
@@ -66,9 +134,12 @@ func Provider() terraform.ResourceProvider {
 			"cmccloudv2_autoscaling_scale_out_policy":    resourceAutoScalingScaleOutPolicy(),
 			"cmccloudv2_autoscaling_az_policy":           resourceAutoScalingAZPolicy(),
 			"cmccloudv2_autoscaling_lb_policy":           resourceAutoScalingLBPolicy(),
+			"cmccloudv2_autoscaling_alarm":               resourceAutoScalingAlarm(),
+			"cmccloudv2_autoscaling_schedule":            resourceAutoScalingSchedule(),
 			"cmccloudv2_server_interface":                resourceServerInterface(),
 			"cmccloudv2_redis_instance":                  resourceRedisInstance(),
 			"cmccloudv2_redis_configuration":             resourceRedisConfiguration(),
+			"cmccloudv2_mongodb_instance":                resourceMongodbInstance(),
 			"cmccloudv2_keymanagement_container":         resourceKeyManagementContainer(),
 			"cmccloudv2_keymanagement_secret":            resourceKeyManagementSecret(),
 			"cmccloudv2_keymanagement_token":             resourceKeyManagementToken(),
@@ -103,6 +174,7 @@ func Provider() terraform.ResourceProvider {
 			"cmccloudv2_volume_type":               datasourceVolumeType(),
 			"cmccloudv2_volume_type_database":      datasourceVolumeTypeDatabase(),
 			"cmccloudv2_server":                    datasourceServer(),
+			"cmccloudv2_servers":                   datasourceServers(),
 			"cmccloudv2_keypair":                   datasourceKeypair(),
 			"cmccloudv2_backup":                    datasourceVolumeBackup(),
 			"cmccloudv2_snapshot":                  datasourceVolumeSnapshot(),
@@ -111,6 +183,11 @@ func Provider() terraform.ResourceProvider {
 			"cmccloudv2_redis_configuration":       datasourceRedisConfiguration(),
 			"cmccloudv2_security_group":            datasourceSecurityGroup(),
 			"cmccloudv2_keymanagement_container":   datasourceKeyManagementContainer(),
+			"cmccloudv2_kubernetesv2_kubeconfig":   datasourceKubernetesv2Kubeconfig(),
+			"cmccloudv2_kubernetesv2":              datasourceKubernetesv2(),
+			"cmccloudv2_kubernetesv2_nodegroups":   datasourceKubernetesv2NodeGroups(),
+			"cmccloudv2_kubernetesv2_versions":     datasourceKubernetesv2Versions(),
+			"cmccloudv2_kubernetes_migration":      datasourceKubernetesMigration(),
 
 			"cmccloudv2_devops_project":          datasourceDevopsProject(),
 			"cmccloudv2_container_registry_repo": datasourceContainerRegistryRepository(),
@@ -118,6 +195,8 @@ func Provider() terraform.ResourceProvider {
 			// "cmccloudv2_redis_instance":                  datasourceRedisInstance(),
 		},
 	}
+	withRetryResources(p.ResourcesMap)
+	withRetryResources(p.DataSourcesMap)
 	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -132,12 +211,87 @@ func Provider() terraform.ResourceProvider {
 
 /**/
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+	if d.Get("retry_min_backoff").(int) > d.Get("retry_max_backoff").(int) {
+		return nil, fmt.Errorf("retry_min_backoff must be less than or equal to retry_max_backoff")
+	}
 	config := Config{
 		APIEndpoint: d.Get("api_endpoint").(string),
 		APIKey:      d.Get("api_key").(string),
 		ProjectId:   d.Get("project_id").(string),
 		RegionId:    d.Get("region_id").(string),
+
+		MaxRetries:      d.Get("max_retries").(int),
+		RetryMinBackoff: time.Duration(d.Get("retry_min_backoff").(int)) * time.Second,
+		RetryMaxBackoff: time.Duration(d.Get("retry_max_backoff").(int)) * time.Second,
 		// TerraformVersion: terraformVersion,
 	}
+	if block := getFirstBlock(d, "default_tags"); block != nil {
+		config.DefaultTags = getStringArrayFromTypeSet(block["tags"].(*schema.Set))
+	}
+	if err := loadProviderProfile(d, &config); err != nil {
+		return nil, err
+	}
 	return config.Client()
 }
+
+// loadProviderProfile lay cac gia tri con thieu tu profile trong shared config file,
+// gia tri khai bao trong provider block hoac bien moi truong duoc uu tien hon
+func loadProviderProfile(d *schema.ResourceData, config *Config) error {
+	profile := d.Get("profile").(string)
+	path := d.Get("shared_config_file").(string)
+	explicit := profile != "" || path != ""
+	if profile == "" {
+		profile = defaultProfile
+	}
+	if path == "" {
+		path = defaultSharedConfigFile
+	}
+
+	if explicit {
+		values, err := loadSharedConfigProfile(path, profile)
+		if err != nil {
+			return err
+		}
+		applyProfile(config, values)
+	} else if config.APIKey == "" || config.ProjectId == "" || config.RegionId == "" {
+		// khong khai bao profile thi chi doc file mac dinh neu file ton tai
+		if expanded, err := expandHomeDir(path); err == nil {
+			if _, err := os.Stat(expanded); err == nil {
+				values, err := loadSharedConfigProfile(path, profile)
+				if err != nil {
+					return err
+				}
+				applyProfile(config, values)
+			}
+		}
+	}
+
+	if config.APIEndpoint == "" {
+		config.APIEndpoint = defaultAPIEndpoint
+	}
+	if config.APIKey == "" {
+		return fmt.Errorf("api_key must be set in provider block, CMC_CLOUD_API_KEY environment variable or shared config file")
+	}
+	if config.ProjectId == "" {
+		return fmt.Errorf("project_id must be set in provider block, CMC_CLOUD_PROJECT_ID environment variable or shared config file")
+	}
+	if config.RegionId == "" {
+		return fmt.Errorf("region_id must be set in provider block, CMC_CLOUD_REGION_ID environment variable or shared config file")
+	}
+	return nil
+}
+
+func applyProfile(config *Config, values map[string]string) {
+	if config.APIEndpoint == "" {
+		config.APIEndpoint = values["api_endpoint"]
+	}
+	if config.APIKey == "" {
+		config.APIKey = values["api_key"]
+	}
+	if config.ProjectId == "" {
+		config.ProjectId = values["project_id"]
+	}
+	if config.RegionId == "" {
+		config.RegionId = values["region_id"]
+	}
+}
@@ -0,0 +1,27 @@
+package cmccloudv2
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestMain(m *testing.M) {
+	// fake api chuyen trang thai sau moi lan GET nen khong can cho lau nhu voi api that
+	waitForState = func(stateConf *resource.StateChangeConf) (interface{}, error) {
+		stateConf.Delay = 0
+		stateConf.MinTimeout = 0
+		stateConf.PollInterval = 10 * time.Millisecond
+		return stateConf.WaitForState()
+	}
+	os.Exit(m.Run())
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
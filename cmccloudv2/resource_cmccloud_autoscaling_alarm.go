@@ -0,0 +1,167 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type autoScalingAlarm struct {
+	ID                 string  `json:"id"`
+	Name               string  `json:"name"`
+	Description        string  `json:"description"`
+	AsGroupID          string  `json:"as_group_id"`
+	PolicyID           string  `json:"policy_id"`
+	Meter              string  `json:"meter"`
+	ComparisonOperator string  `json:"comparison_operator"`
+	Threshold          float64 `json:"threshold"`
+	AggregationMethod  string  `json:"aggregation_method"`
+	Period             int     `json:"period"`
+	EvaluationPeriods  int     `json:"evaluation_periods"`
+	Enabled            bool    `json:"enabled"`
+	State              string  `json:"state"`
+	CreatedAt          string  `json:"created_at"`
+}
+
+func resourceAutoScalingAlarm() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAutoScalingAlarmCreate,
+		Read:   resourceAutoScalingAlarmRead,
+		Update: resourceAutoScalingAlarmUpdate,
+		Delete: resourceAutoScalingAlarmDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAutoScalingAlarmImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        autoscalingAlarmSchema(),
+	}
+}
+
+func resourceAutoScalingAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	groupId := d.Get("as_group_id").(string)
+	policyId := d.Get("policy_id").(string)
+
+	// alarm chi kich hoat duoc policy da attach vao autoscaling group
+	group, err := client.AutoScalingGroup.Get(groupId)
+	if err != nil {
+		return fmt.Errorf("error receiving autoscaling group %s: %v", groupId, err)
+	}
+	if !arrayContains(group.Policies, policyId) {
+		return fmt.Errorf("policy %s is not attached to autoscaling group %s", policyId, groupId)
+	}
+	policy, err := client.AutoScalingPolicy.Get(policyId)
+	if err != nil {
+		return fmt.Errorf("error receiving autoscaling policy %s: %v", policyId, err)
+	}
+	if event := policy.Spec.Properties.Event; event != "CLUSTER_SCALE_IN" && event != "CLUSTER_SCALE_OUT" {
+		return fmt.Errorf("policy %s is not a scale in/scale out policy", policyId)
+	}
+
+	params := buildAutoScalingAlarmParams(d)
+	params["as_group_id"] = groupId
+	params["policy_id"] = policyId
+	alarm, err := createAutoScalingAlarm(meta, params)
+	if err != nil {
+		return fmt.Errorf("error creating autoscaling alarm: %v", err)
+	}
+	d.SetId(alarm.ID)
+	return readWithRetry(resourceAutoScalingAlarmRead, d, meta)
+}
+
+func buildAutoScalingAlarmParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                d.Get("name").(string),
+		"description":         d.Get("description").(string),
+		"meter":               d.Get("meter").(string),
+		"comparison_operator": d.Get("comparison_operator").(string),
+		"threshold":           d.Get("threshold").(float64),
+		"aggregation_method":  d.Get("aggregation_method").(string),
+		"period":              d.Get("period").(int),
+		"evaluation_periods":  d.Get("evaluation_periods").(int),
+		"enabled":             d.Get("enabled").(bool),
+	}
+}
+
+func resourceAutoScalingAlarmRead(d *schema.ResourceData, meta interface{}) error {
+	alarm, err := getAutoScalingAlarm(meta, d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving autoscaling alarm")
+	}
+	_ = d.Set("name", alarm.Name)
+	_ = d.Set("description", alarm.Description)
+	_ = d.Set("as_group_id", alarm.AsGroupID)
+	_ = d.Set("policy_id", alarm.PolicyID)
+	_ = d.Set("meter", alarm.Meter)
+	_ = d.Set("comparison_operator", alarm.ComparisonOperator)
+	_ = d.Set("threshold", alarm.Threshold)
+	_ = d.Set("aggregation_method", alarm.AggregationMethod)
+	_ = d.Set("period", alarm.Period)
+	_ = d.Set("evaluation_periods", alarm.EvaluationPeriods)
+	_ = d.Set("enabled", alarm.Enabled)
+	_ = d.Set("state", alarm.State)
+	_ = d.Set("created_at", alarm.CreatedAt)
+	return nil
+}
+
+func resourceAutoScalingAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+	_, err := updateAutoScalingAlarm(meta, d.Id(), buildAutoScalingAlarmParams(d))
+	if err != nil {
+		return fmt.Errorf("error when update autoscaling alarm [%s]: %v", d.Id(), err)
+	}
+	return readWithRetry(resourceAutoScalingAlarmRead, d, meta)
+}
+
+func resourceAutoScalingAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+	_, err := deleteAutoScalingAlarm(meta, d.Id())
+	if err != nil {
+		return fmt.Errorf("error delete autoscaling alarm: %v", err)
+	}
+	_, err = waitUntilResourceDeleted(d, meta, WaitConf{
+		Delay:      3 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}, func(id string) (any, error) {
+		return getAutoScalingAlarm(meta, id)
+	})
+	if err != nil {
+		return fmt.Errorf("error delete autoscaling alarm: %v", err)
+	}
+	return nil
+}
+
+func resourceAutoScalingAlarmImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	err := readWithRetry(resourceAutoScalingAlarmRead, d, meta)
+	return []*schema.ResourceData{d}, err
+}
+
+// gocmcapiv2 chua ho tro alarm, goi truc tiep as api
+func getAutoScalingAlarm(meta interface{}, id string) (autoScalingAlarm, error) {
+	jsonStr, err := getClient(meta).Get("as/alarm/"+id, map[string]string{})
+	var alarm autoScalingAlarm
+	if err == nil {
+		err = json.Unmarshal([]byte(jsonStr), &alarm)
+	}
+	return alarm, err
+}
+func createAutoScalingAlarm(meta interface{}, params map[string]interface{}) (autoScalingAlarm, error) {
+	jsonStr, err := getClient(meta).Post("as/alarm", params)
+	var alarm autoScalingAlarm
+	if err != nil {
+		return alarm, err
+	}
+	err = json.Unmarshal([]byte(jsonStr), &alarm)
+	return alarm, err
+}
+func updateAutoScalingAlarm(meta interface{}, id string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformUpdate("as/alarm/"+id, params)
+}
+func deleteAutoScalingAlarm(meta interface{}, id string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("as/alarm/" + id)
+}
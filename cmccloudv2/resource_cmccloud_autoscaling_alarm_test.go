@@ -0,0 +1,76 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccAutoScalingAlarm_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	policyID := f.seedAutoScalingPolicy("CLUSTER_SCALE_OUT")
+	groupID := f.seedAutoScalingGroup(policyID)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("asalarm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoScalingAlarmConfig(f, groupID, policyID, 80, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_alarm.test", "as_group_id", groupID),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_alarm.test", "policy_id", policyID),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_alarm.test", "meter", "cpu"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_alarm.test", "threshold", "80"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_alarm.test", "aggregation_method", "mean"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_alarm.test", "period", "300"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_alarm.test", "state", "insufficient data"),
+				),
+			},
+			{
+				Config: testAccAutoScalingAlarmConfig(f, groupID, policyID, 90.5, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_alarm.test", "threshold", "90.5"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_alarm.test", "enabled", "false"),
+					f.testCheckRequested("PUT", "as/alarm/{id}"),
+				),
+			},
+			{
+				Config:            testAccAutoScalingAlarmConfig(f, groupID, policyID, 90.5, false),
+				ResourceName:      "cmccloudv2_autoscaling_alarm.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingAlarm_policyNotAttached(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	policyID := f.seedAutoScalingPolicy("CLUSTER_SCALE_OUT")
+	groupID := f.seedAutoScalingGroup()
+	resource.UnitTest(t, resource.TestCase{
+		Providers: f.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutoScalingAlarmConfig(f, groupID, policyID, 80, true),
+				ExpectError: regexp.MustCompile("is not attached to autoscaling group"),
+			},
+		},
+	})
+}
+
+func testAccAutoScalingAlarmConfig(f *fakeCMCCloudAPI, groupID string, policyID string, threshold float64, enabled bool) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_autoscaling_alarm" "test" {
+  name                = "alarm-cpu-high"
+  as_group_id         = %q
+  policy_id           = %q
+  meter               = "cpu"
+  comparison_operator = "gt"
+  threshold           = %v
+  enabled             = %t
+}
+`, groupID, policyID, threshold, enabled)
+}
@@ -38,14 +38,14 @@ func resourceAutoScalingAZPolicyCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error creating az policy: %v", err.Error())
 	}
 	d.SetId(res.ID)
-	return resourceAutoScalingAZPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingAZPolicyRead, d, meta)
 }
 
 func resourceAutoScalingAZPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	policy, err := client.AutoScalingPolicy.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving az policy %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving az policy")
 	}
 
 	zoneNames := make([]string, len(policy.Spec.Properties.Zones))
@@ -69,7 +69,7 @@ func resourceAutoScalingAZPolicyUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("error when update az policy [%s]: %v", d.Id(), err)
 		}
 	}
-	return resourceAutoScalingAZPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingAZPolicyRead, d, meta)
 }
 
 func resourceAutoScalingAZPolicyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -87,6 +87,6 @@ func resourceAutoScalingAZPolicyDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAutoScalingAZPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceAutoScalingAZPolicyRead(d, meta)
+	err := readWithRetry(resourceAutoScalingAZPolicyRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
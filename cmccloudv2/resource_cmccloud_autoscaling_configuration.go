@@ -62,14 +62,14 @@ func resourceAutoScalingConfigurationCreate(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("error creating configuration: %v", err.Error())
 	}
 	d.SetId(res.ID)
-	return resourceAutoScalingConfigurationRead(d, meta)
+	return readWithRetry(resourceAutoScalingConfigurationRead, d, meta)
 }
 
 func resourceAutoScalingConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	configuration, err := client.AutoScalingConfiguration.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving configuration %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving configuration")
 	}
 	_ = d.Set("name", configuration.Name)
 	_ = d.Set("flavor_id", configuration.Spec.Properties.FlavorID)
@@ -123,7 +123,7 @@ func resourceAutoScalingConfigurationUpdate(d *schema.ResourceData, meta interfa
 			return fmt.Errorf("error when rename autoscale configuration [%s] from `%s` to `%s`: %v", id, nameOld, nameNew, err)
 		}
 	}
-	return resourceAutoScalingConfigurationRead(d, meta)
+	return readWithRetry(resourceAutoScalingConfigurationRead, d, meta)
 }
 
 func resourceAutoScalingConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
@@ -141,7 +141,7 @@ func resourceAutoScalingConfigurationDelete(d *schema.ResourceData, meta interfa
 }
 
 func resourceAutoScalingConfigurationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceAutoScalingConfigurationRead(d, meta)
+	err := readWithRetry(resourceAutoScalingConfigurationRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -43,14 +43,14 @@ func resourceAutoScalingDeletePolicyCreate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("error creating delete policy: %v", err.Error())
 	}
 	d.SetId(res.ID)
-	return resourceAutoScalingDeletePolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingDeletePolicyRead, d, meta)
 }
 
 func resourceAutoScalingDeletePolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	policy, err := client.AutoScalingPolicy.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving delete policy %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving delete policy")
 	}
 	_ = d.Set("name", policy.Name)
 	_ = d.Set("criteria", policy.Spec.Properties.Criteria)
@@ -74,7 +74,7 @@ func resourceAutoScalingDeletePolicyUpdate(d *schema.ResourceData, meta interfac
 			return fmt.Errorf("error when update delete policy [%s]: %v", d.Id(), err)
 		}
 	}
-	return resourceAutoScalingDeletePolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingDeletePolicyRead, d, meta)
 }
 
 func resourceAutoScalingDeletePolicyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -92,6 +92,6 @@ func resourceAutoScalingDeletePolicyDelete(d *schema.ResourceData, meta interfac
 }
 
 func resourceAutoScalingDeletePolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceAutoScalingDeletePolicyRead(d, meta)
+	err := readWithRetry(resourceAutoScalingDeletePolicyRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
@@ -1,7 +1,9 @@
 package cmccloudv2
 
 import (
+	"encoding/json"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/cmc-cloud/gocmcapiv2"
@@ -19,6 +21,7 @@ func resourceAutoScalingGroup() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		SchemaVersion: 1,
@@ -69,14 +72,14 @@ func resourceAutoScalingGroupCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error when update autoscaling group capacity [%s]: %v", res.ID, err)
 	}
 
-	return resourceAutoScalingGroupRead(d, meta)
+	return readWithRetry(resourceAutoScalingGroupRead, d, meta)
 }
 
 func resourceAutoScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	autoscalinggroup, err := client.AutoScalingGroup.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving autoscaling group %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving autoscaling group")
 	}
 	_ = d.Set("name", autoscalinggroup.Name)
 	_ = d.Set("min_size", autoscalinggroup.MinSize)
@@ -115,13 +118,23 @@ func resourceAutoScalingGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if d.HasChange("name") || d.HasChange("as_configuration_id") {
-		_, err := client.AutoScalingGroup.Update(id, map[string]interface{}{
+		group, err := client.AutoScalingGroup.Get(id)
+		if err != nil {
+			return fmt.Errorf("error retrieving autoscaling group %s: %v", id, err)
+		}
+		_, err = client.AutoScalingGroup.Update(id, map[string]interface{}{
 			"name":                d.Get("name").(string),
 			"as_configuration_id": d.Get("as_configuration_id").(string),
 		})
 		if err != nil {
 			return fmt.Errorf("error when update autoscaling group [%s]: %v", id, err)
 		}
+		if refresh := getFirstBlock(d, "instance_refresh"); refresh != nil && d.HasChange("as_configuration_id") {
+			// cac node hien co van chay configuration cu, thay the lan luot tung batch
+			if err := refreshAutoScalingGroupInstances(d, meta, group.Nodes, refresh); err != nil {
+				return fmt.Errorf("error when refresh instances of autoscaling group [%s]: %v", id, err)
+			}
+		}
 	}
 	if d.HasChange("min_size") || d.HasChange("max_size") || d.HasChange("desired_capacity") {
 		_, err := client.AutoScalingGroup.UpdateCapacity(id, map[string]interface{}{
@@ -134,7 +147,123 @@ func resourceAutoScalingGroupUpdate(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("error when update autoscaling group capacity [%s]: %v", id, err)
 		}
 	}
-	return resourceAutoScalingGroupRead(d, meta)
+	return readWithRetry(resourceAutoScalingGroupRead, d, meta)
+}
+
+// refreshAutoScalingGroupInstances thay tung batch node cu bang node moi theo configuration moi.
+// Neu xoa node truoc van giu duoc min_healthy_percentage thi xoa batch node cu roi tra lai capacity,
+// nguoc lai (group nho) thi tang capacity truoc (surge), doi node moi chay roi moi xoa node cu
+func refreshAutoScalingGroupInstances(d *schema.ResourceData, meta interface{}, oldNodes []string, refresh map[string]interface{}) error {
+	id := d.Id()
+	total := len(oldNodes)
+	if total == 0 {
+		return nil
+	}
+	batchSize, surge := autoScalingGroupRefreshBatch(total, refresh["min_healthy_percentage"].(int), refresh["batch_size"].(int))
+	pause := time.Duration(refresh["pause_time"].(int)) * time.Second
+
+	for start := 0; start < total; start += batchSize {
+		end := start + batchSize
+		if end > total {
+			end = total
+		}
+		batch := oldNodes[start:end]
+		log.Printf("[INFO] Replacing nodes %v of autoscaling group %s (%d/%d, surge: %t)", batch, id, end, total, surge)
+
+		group, err := getClient(meta).AutoScalingGroup.Get(id)
+		if err != nil {
+			return err
+		}
+		capacity := map[string]interface{}{
+			"min_size":         group.MinSize,
+			"max_size":         group.MaxSize,
+			"desired_capacity": group.DesiredCapacity,
+			"strict":           true,
+		}
+		if surge {
+			// tam nang max_size neu can de group tao du node moi truoc khi xoa node cu
+			desired := group.DesiredCapacity + len(batch)
+			maxSize := group.MaxSize
+			if desired > maxSize {
+				maxSize = desired
+			}
+			err = updateAutoScalingGroupCapacityAndWait(d, meta, id, map[string]interface{}{
+				"min_size":         group.MinSize,
+				"max_size":         maxSize,
+				"desired_capacity": desired,
+				"strict":           true,
+			})
+			if err != nil {
+				return fmt.Errorf("error when add replacement nodes for %v: %v", batch, err)
+			}
+		}
+
+		res, err := deleteAutoScalingGroupNodes(meta, id, batch)
+		if err != nil {
+			return fmt.Errorf("error when delete nodes %v: %v", batch, err)
+		}
+		if _, err = waitUntilAsActionStatusChangedState(d, meta, res.ActionID); err != nil {
+			return fmt.Errorf("error when delete nodes %v: %v", batch, err)
+		}
+
+		// tra lai capacity ban dau: group se tao node moi bang configuration moi (xoa truoc)
+		// hoac chi tra lai max_size (surge)
+		if err = updateAutoScalingGroupCapacityAndWait(d, meta, id, capacity); err != nil {
+			return fmt.Errorf("error when replace nodes %v: %v", batch, err)
+		}
+
+		if end < total && pause > 0 {
+			time.Sleep(pause)
+		}
+	}
+	return nil
+}
+
+// autoScalingGroupRefreshBatch tra ve so node thay moi batch va co can surge hay khong: so node con chay
+// (lam tron len theo min_healthy_percentage) phai giu duoc khi xoa 1 batch, khong xoa truoc duoc node nao thi surge
+func autoScalingGroupRefreshBatch(total int, minHealthyPercentage int, batchSize int) (int, bool) {
+	minHealthy := (total*minHealthyPercentage + 99) / 100
+	surge := total-minHealthy < 1
+	if !surge && total-minHealthy < batchSize {
+		batchSize = total - minHealthy
+	}
+	return batchSize, surge
+}
+
+func updateAutoScalingGroupCapacityAndWait(d *schema.ResourceData, meta interface{}, id string, params map[string]interface{}) error {
+	res, err := updateAutoScalingGroupCapacity(meta, id, params)
+	if err != nil {
+		return fmt.Errorf("error when update autoscaling group capacity [%s]: %v", id, err)
+	}
+	if res.ActionID != "" {
+		_, err = waitUntilAsActionStatusChangedState(d, meta, res.ActionID)
+	} else {
+		_, err = waitUntilAutoscalingGroupStatusChangedState(d, meta, []string{"ACTIVE"}, []string{"CRITICAL", "ERROR"}, d.Timeout(schema.TimeoutUpdate))
+	}
+	return err
+}
+
+// gocmcapiv2 chua ho tro xoa node chi dinh va khong tra ve action id khi update capacity, goi truc tiep as api
+func deleteAutoScalingGroupNodes(meta interface{}, id string, nodes []string) (gocmcapiv2.PolicyActionResponse, error) {
+	var res gocmcapiv2.PolicyActionResponse
+	jsonStr, err := getClient(meta).Post("as/group/"+id+"/del_nodes", map[string]interface{}{
+		"nodes":                  nodes,
+		"destroy_after_deletion": true,
+	})
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal([]byte(jsonStr), &res)
+	return res, err
+}
+func updateAutoScalingGroupCapacity(meta interface{}, id string, params map[string]interface{}) (gocmcapiv2.PolicyActionResponse, error) {
+	var res gocmcapiv2.PolicyActionResponse
+	jsonStr, err := getClient(meta).Put("as/group/"+id+"/capacity", params)
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal([]byte(jsonStr), &res)
+	return res, err
 }
 
 func resourceAutoScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -153,7 +282,7 @@ func resourceAutoScalingGroupDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAutoScalingGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceAutoScalingGroupRead(d, meta)
+	err := readWithRetry(resourceAutoScalingGroupRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -0,0 +1,96 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestAutoScalingGroupRefreshBatch(t *testing.T) {
+	cases := []struct {
+		total, minHealthyPercentage, batchSize int
+		wantBatch                              int
+		wantSurge                              bool
+	}{
+		// 10 node giu 90% = 9 node chay, moi batch chi xoa truoc duoc 1 node
+		{10, 90, 3, 1, false},
+		{10, 50, 3, 3, false},
+		{10, 50, 8, 5, false},
+		{10, 0, 20, 10, false},
+		// lam tron len: 3 node * 50% = 2 node phai chay
+		{3, 50, 2, 1, false},
+		// group nho khong xoa truoc duoc node nao thi surge, giu nguyen batch_size
+		{1, 90, 1, 1, true},
+		{2, 90, 2, 2, true},
+		{10, 99, 3, 3, true},
+	}
+	for _, c := range cases {
+		batch, surge := autoScalingGroupRefreshBatch(c.total, c.minHealthyPercentage, c.batchSize)
+		if batch != c.wantBatch || surge != c.wantSurge {
+			t.Errorf("autoScalingGroupRefreshBatch(%d, %d, %d) = %d, %v, want %d, %v",
+				c.total, c.minHealthyPercentage, c.batchSize, batch, surge, c.wantBatch, c.wantSurge)
+		}
+	}
+}
+
+func TestAutoScalingGroupRefreshInstances(t *testing.T) {
+	cases := []struct {
+		name                 string
+		nodes                int
+		minHealthyPercentage int
+		batchSize            int
+		// cac lan update capacity theo thu tu "min/max/desired"
+		wantUpdates []string
+	}{
+		{
+			// 4 node giu 50%: xoa truoc 2 node moi batch roi tra lai capacity
+			name: "delete first", nodes: 4, minHealthyPercentage: 50, batchSize: 3,
+			wantUpdates: []string{"1/4/4", "1/4/4"},
+		},
+		{
+			// 2 node giu 90%: tang capacity (va max_size) truoc, xoa node cu roi tra lai max_size
+			name: "surge", nodes: 2, minHealthyPercentage: 90, batchSize: 1,
+			wantUpdates: []string{"1/3/3", "1/2/2", "1/3/3", "1/2/2"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			f := newFakeCMCCloudAPI(t)
+			groupID := f.seedAutoScalingGroup()
+			oldNodes := f.seedAutoScalingGroupNodes(groupID, c.nodes)
+
+			d := schema.TestResourceDataRaw(t, resourceAutoScalingGroup().Schema, map[string]interface{}{})
+			d.SetId(groupID)
+			err := refreshAutoScalingGroupInstances(d, f.meta(), oldNodes, map[string]interface{}{
+				"batch_size":             c.batchSize,
+				"min_healthy_percentage": c.minHealthyPercentage,
+				"pause_time":             0,
+			})
+			if err != nil {
+				t.Fatalf("refreshAutoScalingGroupInstances: %v", err)
+			}
+
+			group := f.find("asgroup", groupID)
+			nodes := group["nodes"].([]interface{})
+			if len(nodes) != c.nodes {
+				t.Errorf("group has %d nodes after refresh, want %d", len(nodes), c.nodes)
+			}
+			for _, node := range nodes {
+				for _, old := range oldNodes {
+					if node == old {
+						t.Errorf("node %s was not replaced", old)
+					}
+				}
+			}
+			var updates []string
+			for _, update := range group["capacity_updates"].([]interface{}) {
+				capacity := update.(fakeObject)
+				updates = append(updates, fmt.Sprintf("%d/%d/%d", fakeInt(capacity["min_size"]), fakeInt(capacity["max_size"]), fakeInt(capacity["desired_capacity"])))
+			}
+			if fmt.Sprint(updates) != fmt.Sprint(c.wantUpdates) {
+				t.Errorf("capacity updates = %v, want %v", updates, c.wantUpdates)
+			}
+		})
+	}
+}
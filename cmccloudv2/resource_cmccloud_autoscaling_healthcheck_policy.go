@@ -40,14 +40,14 @@ func resourceAutoScalingHealthCheckPolicyCreate(d *schema.ResourceData, meta int
 		return fmt.Errorf("error creating health check policy: %v", err.Error())
 	}
 	d.SetId(res.ID)
-	return resourceAutoScalingHealthCheckPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingHealthCheckPolicyRead, d, meta)
 }
 
 func resourceAutoScalingHealthCheckPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	policy, err := client.AutoScalingPolicy.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving healcheck policy %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving healcheck policy")
 	}
 	_ = d.Set("name", policy.Name)
 	_ = d.Set("interval", policy.Spec.Properties.Detection.Interval)
@@ -69,7 +69,7 @@ func resourceAutoScalingHealthCheckPolicyUpdate(d *schema.ResourceData, meta int
 			return fmt.Errorf("error when update healcheck policy [%s]: %v", d.Id(), err)
 		}
 	}
-	return resourceAutoScalingHealthCheckPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingHealthCheckPolicyRead, d, meta)
 }
 
 func resourceAutoScalingHealthCheckPolicyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -87,6 +87,6 @@ func resourceAutoScalingHealthCheckPolicyDelete(d *schema.ResourceData, meta int
 }
 
 func resourceAutoScalingHealthCheckPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceAutoScalingHealthCheckPolicyRead(d, meta)
+	err := readWithRetry(resourceAutoScalingHealthCheckPolicyRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
@@ -42,14 +42,14 @@ func resourceAutoScalingLBPolicyCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error creating lb policy: %v", err.Error())
 	}
 	d.SetId(res.ID)
-	return resourceAutoScalingLBPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingLBPolicyRead, d, meta)
 }
 
 func resourceAutoScalingLBPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	policy, err := client.AutoScalingPolicy.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving lb policy %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving lb policy")
 	}
 
 	_ = d.Set("name", policy.Name)
@@ -71,7 +71,7 @@ func resourceAutoScalingLBPolicyUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("error when update lb policy [%s]: %v", d.Id(), err)
 		}
 	}
-	return resourceAutoScalingLBPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingLBPolicyRead, d, meta)
 }
 
 func resourceAutoScalingLBPolicyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -89,6 +89,6 @@ func resourceAutoScalingLBPolicyDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAutoScalingLBPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceAutoScalingLBPolicyRead(d, meta)
+	err := readWithRetry(resourceAutoScalingLBPolicyRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
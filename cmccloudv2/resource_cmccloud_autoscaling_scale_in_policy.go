@@ -41,14 +41,14 @@ func resourceAutoScalingScaleInPolicyCreate(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("error creating scale in policy: %v", err.Error())
 	}
 	d.SetId(res.ID)
-	return resourceAutoScalingScaleInPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingScaleInPolicyRead, d, meta)
 }
 
 func resourceAutoScalingScaleInPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	policy, err := client.AutoScalingPolicy.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving scale in policy %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving scale in policy")
 	}
 	_ = d.Set("name", policy.Name)
 	_ = d.Set("scale_number", policy.Spec.Properties.Adjustment.Number)
@@ -68,7 +68,7 @@ func resourceAutoScalingScaleInPolicyUpdate(d *schema.ResourceData, meta interfa
 			return fmt.Errorf("error when update scale in policy [%s]: %v", d.Id(), err)
 		}
 	}
-	return resourceAutoScalingScaleInPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingScaleInPolicyRead, d, meta)
 }
 
 func resourceAutoScalingScaleInPolicyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -86,6 +86,6 @@ func resourceAutoScalingScaleInPolicyDelete(d *schema.ResourceData, meta interfa
 }
 
 func resourceAutoScalingScaleInPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceAutoScalingScaleInPolicyRead(d, meta)
+	err := readWithRetry(resourceAutoScalingScaleInPolicyRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
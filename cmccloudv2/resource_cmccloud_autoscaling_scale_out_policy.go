@@ -41,14 +41,14 @@ func resourceAutoScalingScaleOutPolicyCreate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("error creating scale out policy: %v", err.Error())
 	}
 	d.SetId(res.ID)
-	return resourceAutoScalingScaleOutPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingScaleOutPolicyRead, d, meta)
 }
 
 func resourceAutoScalingScaleOutPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	policy, err := client.AutoScalingPolicy.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving scale out policy %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving scale out policy")
 	}
 	_ = d.Set("name", policy.Name)
 	_ = d.Set("scale_number", policy.Spec.Properties.Adjustment.Number)
@@ -68,7 +68,7 @@ func resourceAutoScalingScaleOutPolicyUpdate(d *schema.ResourceData, meta interf
 			return fmt.Errorf("error when update scale out policy [%s]: %v", d.Id(), err)
 		}
 	}
-	return resourceAutoScalingScaleOutPolicyRead(d, meta)
+	return readWithRetry(resourceAutoScalingScaleOutPolicyRead, d, meta)
 }
 
 func resourceAutoScalingScaleOutPolicyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -86,7 +86,7 @@ func resourceAutoScalingScaleOutPolicyDelete(d *schema.ResourceData, meta interf
 }
 
 func resourceAutoScalingScaleOutPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceAutoScalingScaleOutPolicyRead(d, meta)
+	err := readWithRetry(resourceAutoScalingScaleOutPolicyRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -0,0 +1,168 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type autoScalingSchedule struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	AsGroupID       string `json:"as_group_id"`
+	Cron            string `json:"cron"`
+	TimeZone        string `json:"time_zone"`
+	MinSize         *int   `json:"min_size"`
+	MaxSize         *int   `json:"max_size"`
+	DesiredCapacity *int   `json:"desired_capacity"`
+	Enabled         bool   `json:"enabled"`
+	NextRunAt       string `json:"next_run_at"`
+	CreatedAt       string `json:"created_at"`
+}
+
+func resourceAutoScalingSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAutoScalingScheduleCreate,
+		Read:   resourceAutoScalingScheduleRead,
+		Update: resourceAutoScalingScheduleUpdate,
+		Delete: resourceAutoScalingScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAutoScalingScheduleImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        autoscalingScheduleSchema(),
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// gia tri chua biet khi plan (lay tu resource khac) thi bo qua kiem tra lien quan
+			minKnown, maxKnown, desiredKnown := diff.NewValueKnown("min_size"), diff.NewValueKnown("max_size"), diff.NewValueKnown("desired_capacity")
+			minSize, maxSize, desired := diff.Get("min_size").(int), diff.Get("max_size").(int), diff.Get("desired_capacity").(int)
+			// -1 la giu nguyen gia tri hien tai cua autoscaling group
+			minSet, maxSet, desiredSet := minSize >= 0, maxSize >= 0, desired >= 0
+			if minKnown && maxKnown && desiredKnown && !minSet && !maxSet && !desiredSet {
+				return fmt.Errorf("at least one of `min_size`, `max_size` or `desired_capacity` must be set")
+			}
+			minSet, maxSet, desiredSet = minSet && minKnown, maxSet && maxKnown, desiredSet && desiredKnown
+			if minSet && maxSet && minSize > maxSize {
+				return fmt.Errorf("`min_size` must be <= `max_size`")
+			}
+			if desiredSet && minSet && desired < minSize {
+				return fmt.Errorf("`desired_capacity` must be >= `min_size`")
+			}
+			if desiredSet && maxSet && desired > maxSize {
+				return fmt.Errorf("`desired_capacity` must be <= `max_size`")
+			}
+			return nil
+		},
+	}
+}
+
+func resourceAutoScalingScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	groupId := d.Get("as_group_id").(string)
+	schedule, err := createAutoScalingSchedule(meta, groupId, buildAutoScalingScheduleParams(d))
+	if err != nil {
+		return fmt.Errorf("error creating autoscaling schedule: %v", err)
+	}
+	d.SetId(schedule.ID)
+	return readWithRetry(resourceAutoScalingScheduleRead, d, meta)
+}
+
+func buildAutoScalingScheduleParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"name":      d.Get("name").(string),
+		"cron":      d.Get("cron").(string),
+		"time_zone": d.Get("time_zone").(string),
+		"enabled":   d.Get("enabled").(bool),
+	}
+	// truong nao khong khai bao thi gui null de giu nguyen gia tri hien tai cua group
+	// gui null voi gia tri -1 de api giu nguyen kich thuoc hien tai cua group
+	for _, key := range []string{"min_size", "max_size", "desired_capacity"} {
+		params[key] = nil
+		if v := d.Get(key).(int); v >= 0 {
+			params[key] = v
+		}
+	}
+	return params
+}
+
+func resourceAutoScalingScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	schedule, err := getAutoScalingSchedule(meta, d.Get("as_group_id").(string), d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving autoscaling schedule")
+	}
+	_ = d.Set("name", schedule.Name)
+	_ = d.Set("cron", schedule.Cron)
+	_ = d.Set("time_zone", schedule.TimeZone)
+	// api tra ve null voi kich thuoc giu nguyen, luu la -1
+	for key, v := range map[string]*int{"min_size": schedule.MinSize, "max_size": schedule.MaxSize, "desired_capacity": schedule.DesiredCapacity} {
+		if v != nil {
+			_ = d.Set(key, *v)
+		} else {
+			_ = d.Set(key, -1)
+		}
+	}
+	_ = d.Set("enabled", schedule.Enabled)
+	_ = d.Set("next_run_at", schedule.NextRunAt)
+	_ = d.Set("created_at", schedule.CreatedAt)
+	return nil
+}
+
+func resourceAutoScalingScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	_, err := updateAutoScalingSchedule(meta, d.Get("as_group_id").(string), d.Id(), buildAutoScalingScheduleParams(d))
+	if err != nil {
+		return fmt.Errorf("error when update autoscaling schedule [%s]: %v", d.Id(), err)
+	}
+	return readWithRetry(resourceAutoScalingScheduleRead, d, meta)
+}
+
+func resourceAutoScalingScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	groupId := d.Get("as_group_id").(string)
+	_, err := deleteAutoScalingSchedule(meta, groupId, d.Id())
+	if err != nil {
+		return fmt.Errorf("error delete autoscaling schedule: %v", err)
+	}
+	_, err = waitUntilResourceDeleted(d, meta, WaitConf{
+		Delay:      3 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}, func(id string) (any, error) {
+		return getAutoScalingSchedule(meta, groupId, id)
+	})
+	if err != nil {
+		return fmt.Errorf("error delete autoscaling schedule: %v", err)
+	}
+	return nil
+}
+
+func resourceAutoScalingScheduleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return importStateWithParentId(d, meta, "as_group_id", true, resourceAutoScalingScheduleRead)
+}
+
+// gocmcapiv2 chua ho tro scheduled action, goi truc tiep as api
+func getAutoScalingSchedule(meta interface{}, groupId string, id string) (autoScalingSchedule, error) {
+	jsonStr, err := getClient(meta).Get("as/group/"+groupId+"/schedule/"+id, map[string]string{})
+	var schedule autoScalingSchedule
+	if err == nil {
+		err = json.Unmarshal([]byte(jsonStr), &schedule)
+	}
+	return schedule, err
+}
+func createAutoScalingSchedule(meta interface{}, groupId string, params map[string]interface{}) (autoScalingSchedule, error) {
+	jsonStr, err := getClient(meta).Post("as/group/"+groupId+"/schedule", params)
+	var schedule autoScalingSchedule
+	if err != nil {
+		return schedule, err
+	}
+	err = json.Unmarshal([]byte(jsonStr), &schedule)
+	return schedule, err
+}
+func updateAutoScalingSchedule(meta interface{}, groupId string, id string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformUpdate("as/group/"+groupId+"/schedule/"+id, params)
+}
+func deleteAutoScalingSchedule(meta interface{}, groupId string, id string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("as/group/" + groupId + "/schedule/" + id)
+}
@@ -0,0 +1,114 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAutoScalingSchedule_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	groupID := f.seedAutoScalingGroup()
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("asschedule"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoScalingScheduleConfig(f, groupID, "0 8 * * 1-5", `
+  min_size         = 2
+  desired_capacity = 3
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "as_group_id", groupID),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "cron", "0 8 * * 1-5"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "time_zone", "Asia/Ho_Chi_Minh"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "min_size", "2"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "desired_capacity", "3"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "max_size", "-1"),
+					resource.TestCheckResourceAttrSet("cmccloudv2_autoscaling_schedule.test", "next_run_at"),
+				),
+			},
+			{
+				// bo min_size thi gui null de api giu nguyen min_size cua group
+				Config: testAccAutoScalingScheduleConfig(f, groupID, "0 20 * * *", `
+  max_size         = 5
+  desired_capacity = 1
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "cron", "0 20 * * *"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "min_size", "-1"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "max_size", "5"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "desired_capacity", "1"),
+					f.testCheckRequested("PUT", "as/group/{id}/schedule/{id}"),
+				),
+			},
+			{
+				Config: testAccAutoScalingScheduleConfig(f, groupID, "0 21 * * *", `
+  max_size         = 5
+  desired_capacity = 1
+`),
+				// min_size da bo khoi cau hinh van phai gui null khi cap nhat truong khac
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "cron", "0 21 * * *"),
+					resource.TestCheckResourceAttr("cmccloudv2_autoscaling_schedule.test", "min_size", "-1"),
+					f.testCheckObject("asschedule", "min_size", nil),
+				),
+			},
+			{
+				Config: testAccAutoScalingScheduleConfig(f, groupID, "0 21 * * *", `
+  max_size         = 5
+  desired_capacity = 1
+`),
+				ResourceName:      "cmccloudv2_autoscaling_schedule.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAutoScalingScheduleImportID("cmccloudv2_autoscaling_schedule.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingSchedule_invalidSizes(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	groupID := f.seedAutoScalingGroup()
+	resource.UnitTest(t, resource.TestCase{
+		Providers: f.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutoScalingScheduleConfig(f, groupID, "0 8 * * *", ""),
+				ExpectError: regexp.MustCompile("at least one of `min_size`, `max_size` or `desired_capacity` must be set"),
+			},
+			{
+				Config: testAccAutoScalingScheduleConfig(f, groupID, "0 8 * * *", `
+  min_size         = 3
+  desired_capacity = 2
+`),
+				ExpectError: regexp.MustCompile("`desired_capacity` must be >= `min_size`"),
+			},
+		},
+	})
+}
+
+// testAccAutoScalingScheduleImportID tra ve import id dang <as_group_id>/<id>
+func testAccAutoScalingScheduleImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+		return rs.Primary.Attributes["as_group_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+func testAccAutoScalingScheduleConfig(f *fakeCMCCloudAPI, groupID string, cron string, sizes string) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_autoscaling_schedule" "test" {
+  as_group_id = %q
+  name        = "schedule-test"
+  cron        = %q
+%s}
+`, groupID, cron, sizes)
+}
@@ -46,13 +46,13 @@ func resourceCDNCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(cdnId)
 
-	return resourceCDNRead(d, meta)
+	return readWithRetry(resourceCDNRead, d, meta)
 }
 
 func resourceCDNRead(d *schema.ResourceData, meta interface{}) error {
 	cdn, err := getClient(meta).CDN.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving cdn site %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving cdn site")
 	}
 
 	domain := strings.ReplaceAll(cdn.OriginServerURL, "https://www.", "")
@@ -95,7 +95,7 @@ func resourceCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error when update cdn site [%s]: %v", id, err)
 	}
 
-	return resourceCDNRead(d, meta)
+	return readWithRetry(resourceCDNRead, d, meta)
 }
 
 func resourceCDNDelete(d *schema.ResourceData, meta interface{}) error {
@@ -112,7 +112,7 @@ func resourceCDNDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCDNImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceCDNRead(d, meta)
+	err := readWithRetry(resourceCDNRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -39,13 +39,13 @@ func resourceCDNCertCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(cdnId)
 
-	return resourceCDNCertRead(d, meta)
+	return readWithRetry(resourceCDNCertRead, d, meta)
 }
 
 func resourceCDNCertRead(d *schema.ResourceData, meta interface{}) error {
 	cdn, err := getClient(meta).CDNCert.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving cdn cert %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving cdn cert")
 	}
 
 	_ = d.Set("id", cdn.ID)
@@ -71,7 +71,7 @@ func resourceCDNCertUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error when update dns cert [%s]: %v", id, err)
 	}
 
-	return resourceCDNCertRead(d, meta)
+	return readWithRetry(resourceCDNCertRead, d, meta)
 }
 func resourceCDNCertDelete(d *schema.ResourceData, meta interface{}) error {
 	_, err := getClient(meta).CDNCert.Delete(d.Id())
@@ -40,14 +40,14 @@ func resourceContainerRegistryRepositoryCreate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error creating Repository: %s", err)
 	}
 	d.SetId(strconv.Itoa(registry.ID))
-	return resourceContainerRegistryRepositoryRead(d, meta)
+	return readWithRetry(resourceContainerRegistryRepositoryRead, d, meta)
 }
 
 func resourceContainerRegistryRepositoryRead(d *schema.ResourceData, meta interface{}) error {
 	devopsProjectId := d.Get("devops_project_id").(string)
 	registry, err := getClient(meta).ContainerRegistry.Get(devopsProjectId, d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Repository %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Repository")
 	}
 
 	_ = d.Set("name", registry.Name)
@@ -67,8 +67,7 @@ func resourceContainerRegistryRepositoryDelete(d *schema.ResourceData, meta inte
 }
 
 func resourceContainerRegistryRepositoryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceContainerRegistryRepositoryRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "devops_project_id", true, resourceContainerRegistryRepositoryRead)
 }
 
 func waitUntilContainerRegistryRepositoryDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -50,14 +50,14 @@ func resourceDatabaseAutoBackupCreate(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(vol.ID)
 
-	return resourceDatabaseAutoBackupRead(d, meta)
+	return readWithRetry(resourceDatabaseAutoBackupRead, d, meta)
 }
 
 func resourceDatabaseAutoBackupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	autobackup, err := client.DatabaseAutoBackup.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Database AutoBackup %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Database AutoBackup")
 	}
 
 	_ = d.Set("name", autobackup.Name)
@@ -95,7 +95,7 @@ func resourceDatabaseAutoBackupUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("error when update Database AutoBackup [%s]: %v", id, err)
 		}
 	}
-	return resourceDatabaseAutoBackupRead(d, meta)
+	return readWithRetry(resourceDatabaseAutoBackupRead, d, meta)
 }
 
 func resourceDatabaseAutoBackupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -113,7 +113,7 @@ func resourceDatabaseAutoBackupDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceDatabaseAutoBackupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceDatabaseAutoBackupRead(d, meta)
+	err := readWithRetry(resourceDatabaseAutoBackupRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
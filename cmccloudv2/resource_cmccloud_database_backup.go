@@ -40,14 +40,14 @@ func resourceDatabaseBackupCreate(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return fmt.Errorf("error creating backup of database instance [%s]: %v", d.Get("instance_id").(string), err)
 	}
-	return resourceDatabaseBackupRead(d, meta)
+	return readWithRetry(resourceDatabaseBackupRead, d, meta)
 }
 
 func resourceDatabaseBackupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	backup, err := client.DatabaseBackup.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving database instance backup %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving database instance backup")
 	}
 
 	_ = d.Set("name", backup.Name)
@@ -76,7 +76,7 @@ func resourceDatabaseBackupUpdate(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("error when rename database instance backup [%s]: %v", id, err)
 		}
 	}
-	return resourceDatabaseBackupRead(d, meta)
+	return readWithRetry(resourceDatabaseBackupRead, d, meta)
 }
 
 func resourceDatabaseBackupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -94,7 +94,7 @@ func resourceDatabaseBackupDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceDatabaseBackupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceDatabaseBackupRead(d, meta)
+	err := readWithRetry(resourceDatabaseBackupRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
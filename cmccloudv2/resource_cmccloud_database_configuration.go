@@ -47,7 +47,7 @@ func resourceDatabaseConfigurationCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("error when update parameters of Database Configuration [%s]: %v", configuration.ID, err)
 	}
 
-	return resourceDatabaseConfigurationRead(d, meta)
+	return readWithRetry(resourceDatabaseConfigurationRead, d, meta)
 }
 
 func convertParameters(obj gocmcapiv2.ArrayOrMap) []map[string]interface{} {
@@ -82,7 +82,7 @@ func resourceDatabaseConfigurationRead(d *schema.ResourceData, meta interface{})
 	client := meta.(*CombinedConfig).goCMCClient()
 	configuration, err := client.DatabaseConfiguration.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Database Configuration %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Database Configuration")
 	}
 
 	_ = d.Set("id", configuration.ID)
@@ -113,7 +113,7 @@ func resourceDatabaseConfigurationUpdate(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("error when update parameters of Database Configuration [%s]: %v", id, err)
 		}
 	}
-	return resourceDatabaseConfigurationRead(d, meta)
+	return readWithRetry(resourceDatabaseConfigurationRead, d, meta)
 }
 
 func convertParametersJsonString(params *schema.Set) string {
@@ -156,7 +156,7 @@ func resourceDatabaseConfigurationDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceDatabaseConfigurationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceDatabaseConfigurationRead(d, meta)
+	err := readWithRetry(resourceDatabaseConfigurationRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
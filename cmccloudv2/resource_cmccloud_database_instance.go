@@ -71,14 +71,14 @@ func resourceDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return fmt.Errorf("error creating Database Instance: %s", err)
 	}
-	return resourceDatabaseInstanceRead(d, meta)
+	return readWithRetry(resourceDatabaseInstanceRead, d, meta)
 }
 
 func resourceDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	instance, err := client.DatabaseInstance.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Database Instance %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Database Instance")
 	}
 
 	_ = d.Set("id", instance.ID)
@@ -161,7 +161,7 @@ func resourceDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("error when update billing mode of Database Instance [%s]: %v", id, err)
 		}
 	}
-	return resourceDatabaseInstanceRead(d, meta)
+	return readWithRetry(resourceDatabaseInstanceRead, d, meta)
 }
 
 func resourceDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -179,7 +179,7 @@ func resourceDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDatabaseInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceDatabaseInstanceRead(d, meta)
+	err := readWithRetry(resourceDatabaseInstanceRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -205,3 +205,20 @@ func waitUntilDatabaseInstanceDeleted(d *schema.ResourceData, meta interface{})
 		return getClient(meta).DatabaseInstance.Get(id)
 	})
 }
+
+// waitUntilDatabaseInstanceEditable doi instance ve ACTIVE truoc/sau khi thao tac voi user, database cua instance
+func waitUntilDatabaseInstanceEditable(instanceId string, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	_, err := waitUntilResourceStatusChanged(d, meta, []string{"ACTIVE"}, []string{"ERROR"}, WaitConf{
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}, func(id string) (any, error) {
+		return getClient(meta).DatabaseInstance.Get(instanceId)
+	}, func(obj interface{}) string {
+		return obj.(gocmcapiv2.DatabaseInstance).Status
+	})
+	if err != nil {
+		return fmt.Errorf("database instance %s is not ready: %v", instanceId, err)
+	}
+	return nil
+}
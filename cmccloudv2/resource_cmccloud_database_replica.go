@@ -0,0 +1,266 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// databaseReplica giong gocmcapiv2.DatabaseInstance nhung co them replica_of.
+// HasReplicaOf = false khi api khong tra ve truong replica_of
+type databaseReplica struct {
+	gocmcapiv2.DatabaseInstance
+	ReplicaOf *struct {
+		ID string `json:"id"`
+	} `json:"replica_of"`
+	HasReplicaOf bool `json:"-"`
+}
+
+func resourceDatabaseReplica() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatabaseReplicaCreate,
+		Read:   resourceDatabaseReplicaRead,
+		Update: resourceDatabaseReplicaUpdate,
+		Delete: resourceDatabaseReplicaDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatabaseReplicaImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        databaseReplicaSchema(),
+		// replica da promote thanh primary thi khong the quay lai lam replica, phai tao lai
+		CustomizeDiff: customdiff.ForceNewIfChange("promote", func(old, new, meta interface{}) bool {
+			return old.(bool) && !new.(bool)
+		}),
+	}
+}
+
+func resourceDatabaseReplicaCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	primaryId := d.Get("primary_instance_id").(string)
+	primary, err := client.DatabaseInstance.Get(primaryId)
+	if err != nil {
+		return fmt.Errorf("error receiving primary Database Instance [%s]: %v", primaryId, err)
+	}
+
+	// replica duoc tao tu primary voi source_type = instance, giong replicate_count cua database_instance
+	replica, err := client.DatabaseInstance.Create(map[string]interface{}{
+		"name":              d.Get("name").(string),
+		"billing_mode":      d.Get("billing_mode").(string),
+		"zone":              d.Get("zone").(string),
+		"volume_type":       d.Get("volume_type").(string),
+		"volume_size":       d.Get("volume_size").(int),
+		"datastore_type":    primary.Datastore.Type,
+		"datastore_version": primary.Datastore.Version,
+		"flavor_id":         d.Get("flavor_id").(string),
+		"source_type":       "instance",
+		"source_id":         primaryId,
+		"replicate_count":   1,
+		"subnets":           d.Get("subnets").([]interface{}),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Database Replica: %s", err)
+	}
+	d.SetId(replica.ID)
+	_, err = waitUntilDatabaseInstanceJobFinished(d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating Database Replica: %s", err)
+	}
+
+	if d.Get("promote").(bool) {
+		err = promoteDatabaseReplicaAndWait(d, meta)
+		if err != nil {
+			return err
+		}
+	}
+	return readWithRetry(resourceDatabaseReplicaRead, d, meta)
+}
+
+func resourceDatabaseReplicaRead(d *schema.ResourceData, meta interface{}) error {
+	replica, err := getDatabaseReplica(meta, d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving Database Replica")
+	}
+
+	_ = d.Set("name", replica.Name)
+	_ = d.Set("billing_mode", replica.BillingMode)
+	_ = d.Set("flavor_id", replica.Flavor.ID)
+	_ = d.Set("volume_size", replica.Volume.Size)
+	_ = d.Set("datastore_type", replica.Datastore.Type)
+	_ = d.Set("datastore_version", replica.Datastore.Version)
+	_ = d.Set("status", replica.Status)
+	_ = d.Set("created_at", replica.Created)
+	// promote luon lay tu config, khong suy ra tu replica_of de tranh bi tao lai replica khi api khong tra ve truong nay
+	if replica.HasReplicaOf {
+		replicaOf := ""
+		if replica.ReplicaOf != nil {
+			replicaOf = replica.ReplicaOf.ID
+		}
+		_ = d.Set("replica_of", replicaOf)
+		if replicaOf != "" {
+			_ = d.Set("primary_instance_id", replicaOf)
+		}
+	}
+
+	// dbaas api khong tra ve zone, volume_type, subnets => lay tu compute instance cua replica
+	if replica.ComputeInstanceID != "" {
+		if err := setDatabaseReplicaComputeInfo(d, meta, replica); err != nil {
+			return fmt.Errorf("error retrieving compute instance of Database Replica %s: %v", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+func setDatabaseReplicaComputeInfo(d *schema.ResourceData, meta interface{}, replica databaseReplica) error {
+	server, err := getClient(meta).Server.Get(replica.ComputeInstanceID, true)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("zone", server.AvailabilityZone)
+
+	// chi set ip_address khi da khai bao, tranh diff (ForceNew) voi ip duoc cap tu dong
+	configured := d.Get("subnets").([]interface{})
+	subnets := make([]map[string]interface{}, 0, len(server.Nics))
+	for i, nic := range server.Nics {
+		if len(nic.FixedIps) == 0 {
+			continue
+		}
+		subnet := map[string]interface{}{
+			"subnet_id": nic.FixedIps[0].SubnetID,
+		}
+		if i < len(configured) {
+			if conf, ok := configured[i].(map[string]interface{}); ok && conf["ip_address"].(string) != "" {
+				subnet["ip_address"] = nic.FixedIps[0].IPAddress
+			}
+		}
+		subnets = append(subnets, subnet)
+	}
+	_ = d.Set("subnets", subnets)
+
+	_, datas, err := getServerVolumes(server.VolumesAttached, meta)
+	if err != nil {
+		return err
+	}
+	for _, vol := range datas {
+		if vol.Size == replica.Volume.Size {
+			_ = d.Set("volume_type", vol.VolumeType)
+			break
+		}
+	}
+	return nil
+}
+
+func resourceDatabaseReplicaUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	id := d.Id()
+	if d.HasChange("name") {
+		_, err := client.DatabaseInstance.Update(id, map[string]interface{}{
+			"name": d.Get("name").(string),
+		})
+		if err != nil {
+			return fmt.Errorf("error when rename Database Replica [%s]: %v", id, err)
+		}
+	}
+	if d.HasChange("flavor_id") {
+		_, err := client.DatabaseInstance.Resize(id, d.Get("flavor_id").(string))
+		if err != nil {
+			return fmt.Errorf("error when resize Database Replica [%s] to flavor [%s]: %v", id, d.Get("flavor_id").(string), err)
+		}
+		_, err = waitUntilDatabaseInstanceJobFinished(d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error when resize Database Replica [%s] to flavor [%s]: %v", id, d.Get("flavor_id").(string), err)
+		}
+	}
+	if d.HasChange("volume_size") {
+		_, err := client.DatabaseInstance.ResizeVolume(id, d.Get("volume_size").(int))
+		if err != nil {
+			return fmt.Errorf("error when resize volume Database Replica [%s] to new size: %v", id, err)
+		}
+		_, err = waitUntilDatabaseInstanceJobFinished(d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error when resize volume Database Replica [%s] to new size: %v", id, err)
+		}
+	}
+	if d.HasChange("billing_mode") {
+		_, err := client.BillingMode.SetDatabaseInstanceBilingMode(id, d.Get("billing_mode").(string))
+		if err != nil {
+			return fmt.Errorf("error when update billing mode of Database Replica [%s]: %v", id, err)
+		}
+	}
+	if d.HasChange("promote") && d.Get("promote").(bool) {
+		err := promoteDatabaseReplicaAndWait(d, meta)
+		if err != nil {
+			return err
+		}
+	}
+	return readWithRetry(resourceDatabaseReplicaRead, d, meta)
+}
+
+func resourceDatabaseReplicaDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	_, err := client.DatabaseInstance.Delete(d.Id())
+	if err != nil {
+		return fmt.Errorf("error delete database replica: %v", err)
+	}
+	_, err = waitUntilDatabaseInstanceDeleted(d, meta)
+	if err != nil {
+		return fmt.Errorf("error delete database replica: %v", err)
+	}
+	return nil
+}
+
+func resourceDatabaseReplicaImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := readWithRetry(resourceDatabaseReplicaRead, d, meta); err != nil {
+		return nil, err
+	}
+	// chi khi import moi suy ra promote tu replica_of (neu api co tra ve)
+	replica, err := getDatabaseReplica(meta, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if replica.HasReplicaOf {
+		_ = d.Set("promote", replica.ReplicaOf == nil || replica.ReplicaOf.ID == "")
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func promoteDatabaseReplicaAndWait(d *schema.ResourceData, meta interface{}) error {
+	_, err := promoteDatabaseReplica(meta, d.Id())
+	if err != nil {
+		return fmt.Errorf("error when promote Database Replica [%s]: %v", d.Id(), err)
+	}
+	_, err = waitUntilDatabaseInstanceJobFinished(d, meta, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error when promote Database Replica [%s]: %v", d.Id(), err)
+	}
+	return nil
+}
+
+// gocmcapiv2 chua ho tro replica, goi truc tiep dbaas api
+func getDatabaseReplica(meta interface{}, id string) (databaseReplica, error) {
+	jsonStr, err := getClient(meta).Get("dbaas/instance/"+id, map[string]string{})
+	var replica databaseReplica
+	if err != nil {
+		return replica, err
+	}
+	if err = json.Unmarshal([]byte(jsonStr), &replica); err != nil {
+		return replica, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal([]byte(jsonStr), &fields); err != nil {
+		return replica, err
+	}
+	_, replica.HasReplicaOf = fields["replica_of"]
+	return replica, nil
+}
+func promoteDatabaseReplica(meta interface{}, id string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformAction("dbaas/instance/"+id+"/detach_replica", map[string]interface{}{})
+}
@@ -0,0 +1,88 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDatabaseReplica_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	primaryID := f.seedDatabaseInstance()
+	subnetID := f.seedSubnet("10.32.1.0/24")
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("server", "volume"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseReplicaConfig(f, primaryID, subnetID, "db-replica", "flavor-small", 40, "monthly", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "replica_of", primaryID),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "datastore_type", "mysql"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "datastore_version", "8.0"),
+					// zone, volume_type & subnets lay tu compute instance cua replica
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "zone", "AZ1"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "volume_type", "ssd"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "subnets.0.subnet_id", subnetID),
+				),
+			},
+			{
+				Config: testAccDatabaseReplicaConfig(f, primaryID, subnetID, "db-replica-renamed", "flavor-large", 60, "hourly", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "name", "db-replica-renamed"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "flavor_id", "flavor-large"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "volume_size", "60"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "billing_mode", "hourly"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "volume_type", "ssd"),
+					f.testCheckRequested("POST", "dbaas/instance/{id}/resize"),
+					f.testCheckRequested("POST", "dbaas/instance/{id}/resize_volume"),
+				),
+			},
+			{
+				Config: testAccDatabaseReplicaConfig(f, primaryID, subnetID, "db-replica-renamed", "flavor-large", 60, "hourly", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "promote", "true"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "replica_of", ""),
+					// primary_instance_id giu nguyen sau khi promote, khong tao lai replica
+					resource.TestCheckResourceAttr("cmccloudv2_database_replica.test", "primary_instance_id", primaryID),
+					f.testCheckRequestCount("POST", "dbaas/instance/{id}/detach_replica", 1),
+				),
+			},
+			{
+				// replica da promote khong the quay lai lam replica, phai tao lai
+				Config:             testAccDatabaseReplicaConfig(f, primaryID, subnetID, "db-replica-renamed", "flavor-large", 60, "hourly", false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:            testAccDatabaseReplicaConfig(f, primaryID, subnetID, "db-replica-renamed", "flavor-large", 60, "hourly", true),
+				ResourceName:      "cmccloudv2_database_replica.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// replica da promote khong con tro ve primary
+				ImportStateVerifyIgnore: []string{"primary_instance_id"},
+			},
+		},
+	})
+}
+
+func testAccDatabaseReplicaConfig(f *fakeCMCCloudAPI, primaryID string, subnetID string, name string, flavorID string, volumeSize int, billingMode string, promote bool) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_database_replica" "test" {
+  primary_instance_id = %q
+  name                = %q
+  flavor_id           = %q
+  zone                = "AZ1"
+  volume_type         = "ssd"
+  volume_size         = %d
+  billing_mode        = %q
+  promote             = %t
+
+  subnets {
+    subnet_id = %q
+  }
+}
+`, primaryID, name, flavorID, volumeSize, billingMode, promote, subnetID)
+}
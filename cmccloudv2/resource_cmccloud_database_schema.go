@@ -0,0 +1,129 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type databaseSchema struct {
+	Name         string `json:"name"`
+	CharacterSet string `json:"character_set"`
+	Collate      string `json:"collate"`
+}
+
+func resourceDatabaseSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatabaseSchemaCreate,
+		Read:   resourceDatabaseSchemaRead,
+		Delete: resourceDatabaseSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatabaseSchemaImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        databaseSchemaSchema(),
+	}
+}
+
+func resourceDatabaseSchemaCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceId := d.Get("instance_id").(string)
+	err := waitUntilDatabaseInstanceEditable(instanceId, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	params := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if v, ok := d.GetOk("character_set"); ok {
+		params["character_set"] = v.(string)
+	}
+	if v, ok := d.GetOk("collate"); ok {
+		params["collate"] = v.(string)
+	}
+	_, err = createDatabaseSchema(meta, instanceId, params)
+	if err != nil {
+		return fmt.Errorf("error creating database schema: %v", err)
+	}
+	d.SetId(d.Get("name").(string))
+
+	err = waitUntilDatabaseInstanceEditable(instanceId, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	return readWithRetry(resourceDatabaseSchemaRead, d, meta)
+}
+
+func resourceDatabaseSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	db, err := getDatabaseSchema(meta, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving database schema")
+	}
+	_ = d.Set("name", db.Name)
+	if db.CharacterSet != "" {
+		_ = d.Set("character_set", db.CharacterSet)
+	}
+	if db.Collate != "" {
+		_ = d.Set("collate", db.Collate)
+	}
+	return nil
+}
+
+func resourceDatabaseSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	instanceId := d.Get("instance_id").(string)
+	err := waitUntilDatabaseInstanceEditable(instanceId, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+	_, err = deleteDatabaseSchema(meta, instanceId, d.Id())
+	if err != nil {
+		return fmt.Errorf("error delete database schema [%s]: %v", d.Id(), err)
+	}
+	_, err = waitUntilResourceDeleted(d, meta, WaitConf{
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}, func(id string) (any, error) {
+		return getDatabaseSchema(meta, instanceId, id)
+	})
+	if err != nil {
+		return fmt.Errorf("error delete database schema [%s]: %v", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceDatabaseSchemaImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return importStateWithParentId(d, meta, "instance_id", true, resourceDatabaseSchemaRead)
+}
+
+// gocmcapiv2 chua ho tro quan ly database cua instance, goi truc tiep dbaas api
+func getDatabaseSchema(meta interface{}, instanceId string, name string) (databaseSchema, error) {
+	jsonStr, err := getClient(meta).Get("dbaas/instance/"+instanceId+"/database", map[string]string{})
+	if err != nil {
+		return databaseSchema{}, err
+	}
+	dbs := make([]databaseSchema, 0)
+	err = json.Unmarshal([]byte(jsonStr), &dbs)
+	if err != nil {
+		return databaseSchema{}, err
+	}
+	for _, db := range dbs {
+		if db.Name == name {
+			return db, nil
+		}
+	}
+	return databaseSchema{}, fmt.Errorf("database %s of instance %s: %w", name, instanceId, gocmcapiv2.ErrNotFound)
+}
+func createDatabaseSchema(meta interface{}, instanceId string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformAction("dbaas/instance/"+instanceId+"/database", params)
+}
+func deleteDatabaseSchema(meta interface{}, instanceId string, name string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("dbaas/instance/" + instanceId + "/database/" + url.PathEscape(name))
+}
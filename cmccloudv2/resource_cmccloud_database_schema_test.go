@@ -0,0 +1,53 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDatabaseSchema_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	instanceID := f.seedDatabaseInstance()
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("dbschema"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSchemaConfig(f, instanceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_database_schema.app", "id", "app"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_schema.app", "character_set", "utf8mb4"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_schema.app", "collate", "utf8mb4_general_ci"),
+					// api tu chon character set & collate khi khong cau hinh
+					resource.TestCheckResourceAttr("cmccloudv2_database_schema.default", "character_set", "utf8mb4"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_schema.default", "collate", "utf8mb4_0900_ai_ci"),
+				),
+			},
+			{
+				Config:            testAccDatabaseSchemaConfig(f, instanceID),
+				ResourceName:      "cmccloudv2_database_schema.app",
+				ImportState:       true,
+				ImportStateId:     instanceID + "/app",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDatabaseSchemaConfig(f *fakeCMCCloudAPI, instanceID string) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_database_schema" "app" {
+  instance_id   = %[1]q
+  name          = "app"
+  character_set = "utf8mb4"
+  collate       = "utf8mb4_general_ci"
+}
+
+resource "cmccloudv2_database_schema" "default" {
+  instance_id = %[1]q
+  name        = "reporting"
+}
+`, instanceID)
+}
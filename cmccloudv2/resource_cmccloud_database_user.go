@@ -0,0 +1,205 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type databaseUser struct {
+	Name      string `json:"name"`
+	Host      string `json:"host"`
+	Databases []struct {
+		Name string `json:"name"`
+	} `json:"databases"`
+}
+
+func resourceDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatabaseUserCreate,
+		Read:   resourceDatabaseUserRead,
+		Update: resourceDatabaseUserUpdate,
+		Delete: resourceDatabaseUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatabaseUserImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        databaseUserSchema(),
+	}
+}
+
+func resourceDatabaseUserCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceId := d.Get("instance_id").(string)
+	err := waitUntilDatabaseInstanceEditable(instanceId, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	_, err = createDatabaseUser(meta, instanceId, map[string]interface{}{
+		"name":      d.Get("name").(string),
+		"password":  d.Get("password").(string),
+		"host":      d.Get("host").(string),
+		"databases": getStringArrayFromTypeSet(d.Get("databases").(*schema.Set)),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating database user: %v", err)
+	}
+	d.SetId(databaseUserId(d.Get("name").(string), d.Get("host").(string)))
+
+	err = waitUntilDatabaseInstanceEditable(instanceId, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	return readWithRetry(resourceDatabaseUserRead, d, meta)
+}
+
+func resourceDatabaseUserRead(d *schema.ResourceData, meta interface{}) error {
+	user, err := getDatabaseUser(meta, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving database user")
+	}
+	name, host := parseDatabaseUserId(d.Id())
+	_ = d.Set("name", name)
+	_ = d.Set("host", host)
+	if user.Name != "" {
+		_ = d.Set("name", user.Name)
+	}
+	if user.Host != "" {
+		_ = d.Set("host", user.Host)
+	}
+	databases := make([]string, 0, len(user.Databases))
+	for _, db := range user.Databases {
+		databases = append(databases, db.Name)
+	}
+	_ = d.Set("databases", databases)
+	return nil
+}
+
+func resourceDatabaseUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	instanceId := d.Get("instance_id").(string)
+	err := waitUntilDatabaseInstanceEditable(instanceId, d, meta, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("password") {
+		_, err := updateDatabaseUser(meta, instanceId, d.Id(), map[string]interface{}{
+			"password": d.Get("password").(string),
+		})
+		if err != nil {
+			return fmt.Errorf("error when change password of database user [%s]: %v", d.Id(), err)
+		}
+	}
+	if d.HasChange("databases") {
+		removes, adds := getDiffSet(d.GetChange("databases"))
+		for _, db := range removes.List() {
+			_, err := revokeDatabaseUserAccess(meta, instanceId, d.Id(), db.(string))
+			if err != nil {
+				return fmt.Errorf("error when revoke access to database [%s] from user [%s]: %v", db.(string), d.Id(), err)
+			}
+		}
+		if adds.Len() > 0 {
+			_, err := grantDatabaseUserAccess(meta, instanceId, d.Id(), getStringArrayFromTypeSet(adds))
+			if err != nil {
+				return fmt.Errorf("error when grant database access to user [%s]: %v", d.Id(), err)
+			}
+		}
+	}
+
+	err = waitUntilDatabaseInstanceEditable(instanceId, d, meta, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	return readWithRetry(resourceDatabaseUserRead, d, meta)
+}
+
+func resourceDatabaseUserDelete(d *schema.ResourceData, meta interface{}) error {
+	instanceId := d.Get("instance_id").(string)
+	err := waitUntilDatabaseInstanceEditable(instanceId, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+	_, err = deleteDatabaseUser(meta, instanceId, d.Id())
+	if err != nil {
+		return fmt.Errorf("error delete database user [%s]: %v", d.Id(), err)
+	}
+	_, err = waitUntilResourceDeleted(d, meta, WaitConf{
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}, func(id string) (any, error) {
+		return getDatabaseUser(meta, instanceId, id)
+	})
+	if err != nil {
+		return fmt.Errorf("error delete database user [%s]: %v", d.Id(), err)
+	}
+	return nil
+}
+
+// import voi id dang <instance_id>/<name>@<host>, bo @<host> thi host = %
+func resourceDatabaseUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// host co the chua '/' (vd 10.0.0.0/255.255.255.0) nen chi tach theo '/' dau tien
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import id %q, expected <instance_id>/<name>@<host>", d.Id())
+	}
+	_ = d.Set("instance_id", parts[0])
+	name, host := parseDatabaseUserId(parts[1])
+	d.SetId(databaseUserId(name, host))
+	if err := readWithRetry(resourceDatabaseUserRead, d, meta); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("database user %s not found in instance %s", parts[1], parts[0])
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// mysql xac dinh user bang ca name & host nen id la <name>@<host>
+func databaseUserId(name string, host string) string {
+	return name + "@" + host
+}
+
+func parseDatabaseUserId(id string) (string, string) {
+	if i := strings.LastIndex(id, "@"); i >= 0 {
+		return id[:i], id[i+1:]
+	}
+	return id, "%"
+}
+
+// gocmcapiv2 chua ho tro quan ly user cua database instance, goi truc tiep dbaas api.
+// userId (<name>@<host>) va ten database duoc escape khi dua vao url
+func getDatabaseUser(meta interface{}, instanceId string, userId string) (databaseUser, error) {
+	jsonStr, err := getClient(meta).Get("dbaas/instance/"+instanceId+"/user/"+url.PathEscape(userId), map[string]string{})
+	var user databaseUser
+	if err == nil {
+		err = json.Unmarshal([]byte(jsonStr), &user)
+	}
+	return user, err
+}
+func createDatabaseUser(meta interface{}, instanceId string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformAction("dbaas/instance/"+instanceId+"/user", params)
+}
+func updateDatabaseUser(meta interface{}, instanceId string, userId string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformUpdate("dbaas/instance/"+instanceId+"/user/"+url.PathEscape(userId), params)
+}
+func grantDatabaseUserAccess(meta interface{}, instanceId string, userId string, databases []string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformUpdate("dbaas/instance/"+instanceId+"/user/"+url.PathEscape(userId)+"/databases", map[string]interface{}{
+		"databases": databases,
+	})
+}
+func revokeDatabaseUserAccess(meta interface{}, instanceId string, userId string, database string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("dbaas/instance/" + instanceId + "/user/" + url.PathEscape(userId) + "/databases/" + url.PathEscape(database))
+}
+func deleteDatabaseUser(meta interface{}, instanceId string, userId string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("dbaas/instance/" + instanceId + "/user/" + url.PathEscape(userId))
+}
@@ -0,0 +1,125 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestParseDatabaseUserId(t *testing.T) {
+	cases := []struct {
+		id, name, host string
+	}{
+		{"app@%", "app", "%"},
+		{"app@10.0.0.%", "app", "10.0.0.%"},
+		{"app@10.0.0.0/255.255.255.0", "app", "10.0.0.0/255.255.255.0"},
+		// ten user co the chua '@', host luon nam sau '@' cuoi cung
+		{"ops@team@localhost", "ops@team", "localhost"},
+		{"app", "app", "%"},
+	}
+	for _, c := range cases {
+		name, host := parseDatabaseUserId(c.id)
+		if name != c.name || host != c.host {
+			t.Errorf("parseDatabaseUserId(%q) = %q, %q, want %q, %q", c.id, name, host, c.name, c.host)
+		}
+		if c.id != "app" && databaseUserId(name, host) != c.id {
+			t.Errorf("databaseUserId(%q, %q) = %q, want %q", name, host, databaseUserId(name, host), c.id)
+		}
+	}
+}
+
+func TestAccDatabaseUser_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	instanceID := f.seedDatabaseInstance()
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("dbuser", "dbschema"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseUserConfig(f, instanceID, "Passw0rd!", "app"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_database_user.app", "id", "app@%"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_user.app", "host", "%"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_user.app", "databases.#", "1"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_user.office", "id", "office@10.0.0.0/255.255.255.0"),
+					resource.TestCheckResourceAttr("cmccloudv2_database_user.office", "databases.#", "0"),
+					f.testCheckRequested("POST", "dbaas/instance/{id}/user"),
+				),
+			},
+			{
+				// doi password & chuyen quyen tu app sang reporting
+				Config: testAccDatabaseUserConfig(f, instanceID, "N3wPassw0rd!", "reporting"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_database_user.app", "databases.#", "1"),
+					f.testCheckRequested("PUT", "dbaas/instance/{id}/user/{id}"),
+					f.testCheckRequested("DELETE", "dbaas/instance/{id}/user/{id}/databases/{id}"),
+					f.testCheckRequested("PUT", "dbaas/instance/{id}/user/{id}/databases"),
+					func(_ *terraform.State) error {
+						f.mu.Lock()
+						defer f.mu.Unlock()
+						user := f.find("dbuser", instanceID+"/app@%")
+						if user["password"] != "N3wPassw0rd!" || fmt.Sprint(user["databases"]) != "[reporting]" {
+							return fmt.Errorf("user app@%% = %v", user)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:                  testAccDatabaseUserConfig(f, instanceID, "N3wPassw0rd!", "reporting"),
+				ResourceName:            "cmccloudv2_database_user.app",
+				ImportState:             true,
+				ImportStateId:           instanceID + "/app@%",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				// bo @<host> thi host = %
+				Config:                  testAccDatabaseUserConfig(f, instanceID, "N3wPassw0rd!", "reporting"),
+				ResourceName:            "cmccloudv2_database_user.app",
+				ImportState:             true,
+				ImportStateId:           instanceID + "/app",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config:                  testAccDatabaseUserConfig(f, instanceID, "N3wPassw0rd!", "reporting"),
+				ResourceName:            "cmccloudv2_database_user.office",
+				ImportState:             true,
+				ImportStateId:           instanceID + "/office@10.0.0.0/255.255.255.0",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccDatabaseUserConfig(f *fakeCMCCloudAPI, instanceID string, password string, database string) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_database_schema" "app" {
+  instance_id = %[1]q
+  name        = "app"
+}
+
+resource "cmccloudv2_database_schema" "reporting" {
+  instance_id = %[1]q
+  name        = "reporting"
+}
+
+resource "cmccloudv2_database_user" "app" {
+  instance_id = %[1]q
+  name        = "app"
+  password    = %[2]q
+  databases   = [cmccloudv2_database_schema.%[3]s.name]
+}
+
+resource "cmccloudv2_database_user" "office" {
+  instance_id = %[1]q
+  name        = "office"
+  password    = %[2]q
+  host        = "10.0.0.0/255.255.255.0"
+}
+`, instanceID, password, database)
+}
@@ -62,13 +62,13 @@ func resourceDevopsProjectCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error creating Devops Project : %s", err)
 	}
 	d.SetId(strconv.Itoa(devopsproject.ID))
-	return resourceDevopsProjectRead(d, meta)
+	return readWithRetry(resourceDevopsProjectRead, d, meta)
 }
 
 func resourceDevopsProjectRead(d *schema.ResourceData, meta interface{}) error {
 	devopsproject, err := getClient(meta).DevopsProject.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Devops Project %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Devops Project")
 	}
 
 	_ = d.Set("name", devopsproject.Name)
@@ -87,7 +87,7 @@ func resourceDevopsProjectDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDevopsProjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceDevopsProjectRead(d, meta)
+	err := readWithRetry(resourceDevopsProjectRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
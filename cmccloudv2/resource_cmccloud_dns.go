@@ -43,13 +43,13 @@ func resourceDnsCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(zone.ID)
 
-	return resourceDnsRead(d, meta)
+	return readWithRetry(resourceDnsRead, d, meta)
 }
 
 func resourceDnsRead(d *schema.ResourceData, meta interface{}) error {
 	zone, err := getClient(meta).Dns.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Dns %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Dns")
 	}
 
 	_ = d.Set("id", zone.ID)
@@ -75,7 +75,7 @@ func resourceDnsDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDnsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceDnsRead(d, meta)
+	err := readWithRetry(resourceDnsRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
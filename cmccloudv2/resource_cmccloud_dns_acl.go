@@ -39,7 +39,7 @@ func resourceDnsAclCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(acl.ID)
 
-	return resourceDnsAclRead(d, meta)
+	return readWithRetry(resourceDnsAclRead, d, meta)
 }
 
 func resourceDnsAclUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -56,12 +56,12 @@ func resourceDnsAclUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error when update dns acl [%s]: %v", id, err)
 	}
 
-	return resourceDnsAclRead(d, meta)
+	return readWithRetry(resourceDnsAclRead, d, meta)
 }
 func resourceDnsAclRead(d *schema.ResourceData, meta interface{}) error {
 	acl, err := getClient(meta).DnsAcl.Get(d.Get("zone_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving DnsAcl %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving DnsAcl")
 	}
 
 	_ = d.Set("id", acl.ID)
@@ -89,8 +89,7 @@ func resourceDnsAclDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDnsAclImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceDnsAclRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "zone_id", true, resourceDnsAclRead)
 }
 
 func waitUntilDnsAclDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -41,7 +41,7 @@ func resourceDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(record.ID)
 
-	return resourceDnsRecordRead(d, meta)
+	return readWithRetry(resourceDnsRecordRead, d, meta)
 }
 
 func resourceDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -59,12 +59,12 @@ func resourceDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error when update dns record [%s]: %v", id, err)
 	}
 
-	return resourceDnsRecordRead(d, meta)
+	return readWithRetry(resourceDnsRecordRead, d, meta)
 }
 func resourceDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
 	record, err := getClient(meta).DnsRecord.Get(d.Get("zone_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving DnsRecord %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving DnsRecord")
 	}
 
 	_ = d.Set("id", record.ID)
@@ -124,8 +124,7 @@ func resourceDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDnsRecordImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceDnsRecordRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "zone_id", true, resourceDnsRecordRead)
 }
 
 func waitUntilDnsRecordDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -53,14 +53,14 @@ func resourceEcsGroupCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating EcsGroup: %s", err)
 	}
 	d.SetId(vpc.ID)
-	return resourceEcsGroupRead(d, meta)
+	return readWithRetry(resourceEcsGroupRead, d, meta)
 }
 
 func resourceEcsGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	vpc, err := client.EcsGroup.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving EcsGroup %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving EcsGroup")
 	}
 
 	_ = d.Set("id", vpc.ID)
@@ -70,7 +70,7 @@ func resourceEcsGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEcsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceEcsGroupRead(d, meta)
+	return readWithRetry(resourceEcsGroupRead, d, meta)
 }
 
 func resourceEcsGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -89,7 +89,7 @@ func resourceEcsGroupDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEcsGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceEcsGroupRead(d, meta)
+	err := readWithRetry(resourceEcsGroupRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -22,6 +22,7 @@ func resourceEFS() *schema.Resource {
 		},
 		SchemaVersion: 1,
 		Schema:        efsSchema(),
+		CustomizeDiff: customizeDiffTagsAll,
 	}
 }
 
@@ -34,7 +35,7 @@ func resourceEFSCreate(d *schema.ResourceData, meta interface{}) error {
 		"description":   d.Get("description").(string),
 		"type":          d.Get("type").(string),
 		"protocol_type": d.Get("protocol_type").(string),
-		"tags":          d.Get("tags").(*schema.Set).List(),
+		"tags":          getTagsWithDefault(d, meta),
 	}
 	efs, err := getClient(meta).EFS.Create(params)
 
@@ -42,13 +43,13 @@ func resourceEFSCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating EFS: %s", err)
 	}
 	d.SetId(efs.ID)
-	return resourceEFSRead(d, meta)
+	return readWithRetry(resourceEFSRead, d, meta)
 }
 
 func resourceEFSRead(d *schema.ResourceData, meta interface{}) error {
 	efs, err := getClient(meta).EFS.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving EFS %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving EFS")
 	}
 
 	_ = d.Set("id", efs.ID)
@@ -66,7 +67,7 @@ func resourceEFSRead(d *schema.ResourceData, meta interface{}) error {
 	// các field optional phải set riêng
 	setString(d, "description", efs.Description)
 	setString(d, "billing_mode", efs.BillingMode)
-	setTypeSet(d, "tags", efs.Tags)
+	setTagsWithDefault(d, meta, efs.Tags)
 
 	return nil
 }
@@ -74,12 +75,12 @@ func resourceEFSRead(d *schema.ResourceData, meta interface{}) error {
 func resourceEFSUpdate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
-	if d.HasChange("name") || d.HasChange("description") || d.HasChange("tags") || d.HasChange("capacity") {
+	if d.HasChange("name") || d.HasChange("description") || d.HasChanges("tags", "tags_all") || d.HasChange("capacity") {
 		_, err := getClient(meta).EFS.Update(id, map[string]interface{}{
 			"name":        d.Get("name").(string),
 			"description": d.Get("description").(string),
 			"capacity":    d.Get("capacity").(int),
-			"tags":        d.Get("tags").(*schema.Set).List(),
+			"tags":        getTagsWithDefault(d, meta),
 		})
 		if err != nil {
 			return fmt.Errorf("error when update EFS [%s]: %v", id, err)
@@ -92,7 +93,7 @@ func resourceEFSUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return resourceEFSRead(d, meta)
+	return readWithRetry(resourceEFSRead, d, meta)
 }
 
 func resourceEFSDelete(d *schema.ResourceData, meta interface{}) error {
@@ -109,7 +110,7 @@ func resourceEFSDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEFSImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceEFSRead(d, meta)
+	err := readWithRetry(resourceEFSRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
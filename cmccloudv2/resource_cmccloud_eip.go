@@ -24,6 +24,7 @@ func resourceEIP() *schema.Resource {
 		},
 		SchemaVersion: 1,
 		Schema:        eipSchema(),
+		CustomizeDiff: customizeDiffTagsAll,
 	}
 }
 
@@ -34,7 +35,7 @@ func resourceEIPCreate(d *schema.ResourceData, meta interface{}) error {
 		"description":        d.Get("description").(string),
 		"dns_domain":         d.Get("dns_domain").(string),
 		"dns_name":           d.Get("dns_name").(string),
-		"tags":               d.Get("tags").(*schema.Set).List(),
+		"tags":               getTagsWithDefault(d, meta),
 		"billing_mode":       d.Get("billing_mode").(string),
 		"domestic_bandwidth": d.Get("domestic_bandwidth").(int),
 		"inter_bandwidth":    d.Get("inter_bandwidth").(int),
@@ -48,19 +49,19 @@ func resourceEIPCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error creating EIP: %s", err)
 	}
-	return resourceEIPRead(d, meta)
+	return readWithRetry(resourceEIPRead, d, meta)
 }
 
 func resourceEIPRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	eip, err := client.EIP.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving EIP %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving EIP")
 	}
 	_ = d.Set("description", eip.Description)
 	_ = d.Set("dns_domain", eip.DNSDomain)
 	_ = d.Set("dns_name", eip.DNSName)
-	_ = d.Set("tags", eip.Tags)
+	setTagsWithDefault(d, meta, eip.Tags)
 	_ = d.Set("billing_mode", eip.BillingMode)
 	_ = d.Set("domestic_bandwidth", eip.DomesticBandwidthMbps)
 	_ = d.Set("inter_bandwidth", eip.InterBandwidthMbps)
@@ -75,12 +76,12 @@ func resourceEIPRead(d *schema.ResourceData, meta interface{}) error {
 func resourceEIPUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	id := d.Id()
-	if d.HasChange("description") || d.HasChange("domestic_bandwidth") || d.HasChange("inter_bandwidth") || d.HasChange("tags") {
+	if d.HasChange("description") || d.HasChange("domestic_bandwidth") || d.HasChange("inter_bandwidth") || d.HasChanges("tags", "tags_all") {
 		_, err := client.EIP.Update(id, map[string]interface{}{
 			"description":        d.Get("description").(string),
 			"domestic_bandwidth": d.Get("domestic_bandwidth").(int),
 			"inter_bandwidth":    d.Get("inter_bandwidth").(int),
-			"tags":               d.Get("tags").(*schema.Set).List(),
+			"tags":               getTagsWithDefault(d, meta),
 		})
 		if err != nil {
 			return fmt.Errorf("error when update EIP [%s]: %v", id, err)
@@ -102,7 +103,7 @@ func resourceEIPUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("you can't not change dns_name after eip created")
 	}
 
-	return resourceEIPRead(d, meta)
+	return readWithRetry(resourceEIPRead, d, meta)
 }
 
 func resourceEIPDelete(d *schema.ResourceData, meta interface{}) error {
@@ -120,7 +121,7 @@ func resourceEIPDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEIPImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceEIPRead(d, meta)
+	err := readWithRetry(resourceEIPRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
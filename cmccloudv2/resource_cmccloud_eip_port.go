@@ -38,14 +38,14 @@ func resourceEIPPortCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error when attach eip %s to port %s: %s", d.Get("eip_id").(string), d.Get("port_id").(string), err)
 	}
-	return resourceEIPPortRead(d, meta)
+	return readWithRetry(resourceEIPPortRead, d, meta)
 }
 
 func resourceEIPPortRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	eip, err := client.EIP.Get(d.Get("eip_id").(string))
 	if err != nil {
-		return fmt.Errorf("error retrieving eip detail %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving eip detail")
 	}
 	_ = d.Set("eip_id", eip.ID)
 	_ = d.Set("port_id", eip.PortID)
@@ -69,7 +69,9 @@ func resourceEIPPortDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEIPPortImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceEIPPortRead(d, meta)
+	// id cua resource chinh la eip_id
+	_ = d.Set("eip_id", d.Id())
+	err := readWithRetry(resourceEIPPortRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -43,14 +43,14 @@ func resourceEIPPortForwardingRuleCreate(d *schema.ResourceData, meta interface{
 	}
 	d.SetId(rule.ID)
 
-	return resourceEIPPortForwardingRuleRead(d, meta)
+	return readWithRetry(resourceEIPPortForwardingRuleRead, d, meta)
 }
 
 func resourceEIPPortForwardingRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	rule, err := client.EIP.GetPortForwardingRule(d.Get("eip_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving EIP Port Forwarding Rule %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving EIP Port Forwarding Rule")
 	}
 	_ = d.Set("protocol", rule.Protocol)
 	_ = d.Set("internal_ip_address", rule.InternalIPAddress)
@@ -84,7 +84,7 @@ func resourceEIPPortForwardingRuleUpdate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("error when update EIP Port Forwarding Rule [%s]: %v", id, err)
 	}
 
-	return resourceEIPPortForwardingRuleRead(d, meta)
+	return readWithRetry(resourceEIPPortForwardingRuleRead, d, meta)
 }
 
 func resourceEIPPortForwardingRuleDelete(d *schema.ResourceData, meta interface{}) error {
@@ -102,8 +102,7 @@ func resourceEIPPortForwardingRuleDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceEIPPortForwardingRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceEIPPortForwardingRuleRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "eip_id", true, resourceEIPPortForwardingRuleRead)
 }
 
 func waitUntilEIPPortForwardingRuleDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -47,7 +47,7 @@ func resourceELB() *schema.Resource {
 				}
 			}
 
-			return nil
+			return customizeDiffTagsAll(diff, v)
 		},
 	}
 }
@@ -55,11 +55,11 @@ func resourceELB() *schema.Resource {
 func resourceELBUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	id := d.Id()
-	if d.HasChange("name") || d.HasChange("description") || d.HasChange("tags") {
+	if d.HasChange("name") || d.HasChange("description") || d.HasChanges("tags", "tags_all") {
 		_, err := client.ELB.Update(id, map[string]interface{}{
 			"name":        d.Get("name").(string),
 			"description": d.Get("description").(string),
-			"tags":        d.Get("tags").(*schema.Set).List(),
+			"tags":        getTagsWithDefault(d, meta),
 		})
 		if err != nil {
 			return fmt.Errorf("error when update ELB [%s]: %v", id, err)
@@ -97,7 +97,7 @@ func resourceELBUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error when update billing mode of LoadBalancer [%s]: %v", id, err)
 		}
 	}
-	return resourceELBRead(d, meta)
+	return readWithRetry(resourceELBRead, d, meta)
 }
 
 func resourceELBCreate(d *schema.ResourceData, meta interface{}) error {
@@ -117,7 +117,7 @@ func resourceELBCreate(d *schema.ResourceData, meta interface{}) error {
 		"zone":           d.Get("zone").(string),
 		"network_type":   d.Get("network_type").(string),
 		"subnet_id":      d.Get("subnet_id").(string),
-		"tags":           d.Get("tags").(*schema.Set).List(),
+		"tags":           getTagsWithDefault(d, meta),
 		"billing_mode":   d.Get("billing_mode").(string),
 		"bandwidth_mbps": d.Get("bandwidth_mbps").(int),
 	})
@@ -129,14 +129,14 @@ func resourceELBCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error creating ELB: %s", err)
 	}
-	return resourceELBRead(d, meta)
+	return readWithRetry(resourceELBRead, d, meta)
 }
 
 func resourceELBRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	elb, err := client.ELB.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving ELB %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving ELB")
 	}
 
 	networkType := "private"
@@ -149,7 +149,7 @@ func resourceELBRead(d *schema.ResourceData, meta interface{}) error {
 	_ = d.Set("name", elb.Name)
 	_ = d.Set("network_type", networkType)
 	_ = d.Set("created_at", elb.CreatedAt)
-	_ = d.Set("tags", elb.Tags)
+	setTagsWithDefault(d, meta, elb.Tags)
 	_ = d.Set("description", elb.Description)
 	_ = d.Set("operating_status", elb.OperatingStatus)
 	_ = d.Set("provisioning_status", elb.ProvisioningStatus)
@@ -176,7 +176,7 @@ func resourceELBDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceELBImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceELBRead(d, meta)
+	err := readWithRetry(resourceELBRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
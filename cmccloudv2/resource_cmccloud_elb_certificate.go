@@ -0,0 +1,182 @@
+package cmccloudv2
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceELBCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceELBCertificateCreate,
+		Read:   resourceELBCertificateRead,
+		Delete: resourceELBCertificateDelete,
+		// khong ho tro import vi api khong tra ve noi dung certificate
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        elbCertificateSchema(),
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if !diff.NewValueKnown("certificate") || !diff.HasChange("certificate") {
+				return nil
+			}
+			cert, err := parsePEMCertificate(diff.Get("certificate").(string))
+			if err != nil {
+				return fmt.Errorf("invalid `certificate`: %s", err)
+			}
+			// private key co passphrase thi de backend kiem tra
+			if diff.NewValueKnown("private_key") && diff.NewValueKnown("passphrase") && diff.Get("passphrase").(string) == "" {
+				if _, err := tls.X509KeyPair([]byte(diff.Get("certificate").(string)), []byte(diff.Get("private_key").(string))); err != nil {
+					return fmt.Errorf("`private_key` does not match `certificate`: %s", err)
+				}
+			}
+			// biet truoc cac thong tin cua certificate ngay khi plan
+			for key, value := range flattenELBCertificateInfo(cert) {
+				if err := diff.SetNew(key, value); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func resourceELBCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := getClient(meta)
+	container, err := client.KeyManagement.Create(map[string]interface{}{
+		"name": d.Get("name").(string),
+		"type": "certificate",
+	})
+	if err != nil {
+		return fmt.Errorf("error creating ELB Certificate: %s", err)
+	}
+
+	secrets := []interface{}{
+		map[string]interface{}{"name": "certificate", "secretType": "certificate", "content": strings.TrimSpace(d.Get("certificate").(string))},
+		map[string]interface{}{"name": "private_key", "secretType": "private", "content": strings.TrimSpace(d.Get("private_key").(string))},
+	}
+	if v := strings.TrimSpace(d.Get("intermediates").(string)); v != "" {
+		secrets = append(secrets, map[string]interface{}{"name": "intermediates", "secretType": "certificate", "content": v})
+	}
+	if v := d.Get("passphrase").(string); v != "" {
+		secrets = append(secrets, map[string]interface{}{"name": "private_key_passphrase", "secretType": "passphrase", "content": v})
+	}
+	secret, err := client.KeyManagement.CreateSecret(map[string]interface{}{
+		"containerUuid": container.Data.ID,
+		"secretDetails": secrets,
+	})
+	if err != nil {
+		// xoa container vua tao de khong bi rac
+		if _, derr := client.KeyManagement.Delete(container.Data.ID); derr != nil {
+			log.Printf("[WARN] error deleting KeyManagement Container %s after failing to create secrets: %v", container.Data.ID, derr)
+		}
+		return fmt.Errorf("error creating ELB Certificate secrets: %s", err)
+	}
+	d.SetId(container.Data.ID)
+
+	secretIds := make([]string, 0, len(secret.Data.Secrets))
+	for _, s := range secret.Data.Secrets {
+		secretIds = append(secretIds, s.ID)
+	}
+	_ = d.Set("secret_ids", secretIds)
+	return readWithRetry(resourceELBCertificateRead, d, meta)
+}
+
+func resourceELBCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	container, err := getClient(meta).KeyManagement.Get(d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving ELB Certificate")
+	}
+
+	_ = d.Set("name", container.Name)
+	_ = d.Set("container_ref", container.ContainerRef)
+	_ = d.Set("created_at", container.Created)
+
+	// api khong tra ve noi dung certificate, lay thong tin tu certificate trong state
+	if cert, err := parsePEMCertificate(d.Get("certificate").(string)); err == nil {
+		for key, value := range flattenELBCertificateInfo(cert) {
+			_ = d.Set(key, value)
+		}
+	}
+	return nil
+}
+
+func resourceELBCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := getClient(meta)
+	for _, id := range d.Get("secret_ids").([]interface{}) {
+		_, err := client.KeyManagement.DeleteSecret(id.(string))
+		if err != nil && !errors.Is(err, gocmcapiv2.ErrNotFound) {
+			return fmt.Errorf("error delete ELB Certificate secret %s: %v", id, err)
+		}
+	}
+	_, err := client.KeyManagement.Delete(d.Id())
+	if err != nil && !errors.Is(err, gocmcapiv2.ErrNotFound) {
+		return fmt.Errorf("error delete ELB Certificate: %v", err)
+	}
+	return nil
+}
+
+func flattenELBCertificateInfo(cert *x509.Certificate) map[string]interface{} {
+	sans := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses))
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	return map[string]interface{}{
+		"common_name":               cert.Subject.CommonName,
+		"subject_alternative_names": sans,
+		"not_before":                cert.NotBefore.UTC().Format(time.RFC3339),
+		"expiration":                cert.NotAfter.UTC().Format(time.RFC3339),
+	}
+}
+
+// parsePEMCertificate tra ve certificate dau tien trong chuoi PEM
+func parsePEMCertificate(data string) (*x509.Certificate, error) {
+	certs, err := parsePEMCertificates(data)
+	if err != nil {
+		return nil, err
+	}
+	return certs[0], nil
+}
+
+func parsePEMCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(strings.TrimSpace(data))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("data is not in PEM format")
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block type %s, expected CERTIFICATE", block.Type)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found")
+	}
+	return certs, nil
+}
+
+func validatePEMCertificates(v interface{}, k string) (warnings []string, errs []error) {
+	if _, err := parsePEMCertificates(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q must contain PEM encoded certificates: %s", k, err))
+	}
+	return
+}
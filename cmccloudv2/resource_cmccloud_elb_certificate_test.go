@@ -0,0 +1,151 @@
+package cmccloudv2
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+var testAccELBCertificateNotBefore = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testAccELBCertificatePEM sinh certificate tu ky (ECDSA P-256) cho commonName & dnsNames, tra ve certificate & private key dang PEM
+func testAccELBCertificatePEM(t *testing.T, commonName string, dnsNames ...string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.10")},
+		NotBefore:    testAccELBCertificateNotBefore,
+		NotAfter:     testAccELBCertificateNotBefore.AddDate(1, 0, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestParsePEMCertificates(t *testing.T) {
+	leaf, key := testAccELBCertificatePEM(t, "www.example.com")
+	intermediate, _ := testAccELBCertificatePEM(t, "Example Intermediate CA")
+
+	certs, err := parsePEMCertificates("\n" + leaf + "\n\n" + intermediate + "  \n")
+	if err != nil {
+		t.Fatalf("parsePEMCertificates(chain): %v", err)
+	}
+	if len(certs) != 2 || certs[0].Subject.CommonName != "www.example.com" || certs[1].Subject.CommonName != "Example Intermediate CA" {
+		t.Errorf("parsePEMCertificates(chain) returned %d certificates in wrong order", len(certs))
+	}
+	if cert, err := parsePEMCertificate(leaf + intermediate); err != nil || cert.Subject.CommonName != "www.example.com" {
+		t.Errorf("parsePEMCertificate(chain) = %v, %v, want the first certificate", cert, err)
+	}
+
+	garbage := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("not a certificate")}))
+	invalid := []struct {
+		data string
+		want string
+	}{
+		{"", "no certificate found"},
+		{"not a certificate", "data is not in PEM format"},
+		// phan sau certificate hop le cung phai la PEM
+		{leaf + "trailing text", "data is not in PEM format"},
+		{key, "unexpected PEM block type EC PRIVATE KEY, expected CERTIFICATE"},
+		{leaf + key, "unexpected PEM block type EC PRIVATE KEY, expected CERTIFICATE"},
+		{garbage, "x509"},
+	}
+	for _, c := range invalid {
+		if _, err := parsePEMCertificates(c.data); err == nil || !strings.Contains(err.Error(), c.want) {
+			t.Errorf("parsePEMCertificates(%.30q) error = %v, want %q", c.data, err, c.want)
+		}
+	}
+}
+
+func TestAccELBCertificate_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	cert, key := testAccELBCertificatePEM(t, "www.example.com", "www.example.com", "example.com")
+	intermediate, _ := testAccELBCertificatePEM(t, "Example Intermediate CA")
+	renewed, renewedKey := testAccELBCertificatePEM(t, "api.example.com", "api.example.com")
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("kmcontainer", "kmsecret"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccELBCertificateConfig(f, cert, key, fmt.Sprintf("intermediates = %q", intermediate)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_elb_certificate.test", "common_name", "www.example.com"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_certificate.test", "subject_alternative_names.#", "3"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_certificate.test", "subject_alternative_names.2", "10.0.0.10"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_certificate.test", "not_before", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_certificate.test", "expiration", "2025-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_certificate.test", "secret_ids.#", "3"),
+					resource.TestMatchResourceAttr("cmccloudv2_elb_certificate.test", "container_ref", regexp.MustCompile(`/containers/[0-9a-f-]+$`)),
+					f.testCheckObject("kmcontainer", "containerType", "certificate"),
+				),
+			},
+			{
+				// doi certificate thi tao lai container moi
+				Config: testAccELBCertificateConfig(f, renewed, renewedKey, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_elb_certificate.test", "common_name", "api.example.com"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_certificate.test", "secret_ids.#", "2"),
+					f.testCheckRequestCount("POST", "cloudops-core/api/v1/bbc-keys/containers", 2),
+					f.testCheckRequestCount("DELETE", "cloudops-core/api/v1/bbc-keys/secrets/{id}", 3),
+				),
+			},
+			{
+				Config:      testAccELBCertificateConfig(f, cert, renewedKey, ""),
+				ExpectError: regexp.MustCompile("`private_key` does not match `certificate`"),
+			},
+		},
+	})
+}
+
+func TestAccELBCertificate_secretsFailed(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	cert, key := testAccELBCertificatePEM(t, "www.example.com")
+	f.failNext("POST", "cloudops-core/api/v1/bbc-keys/secrets", http.StatusBadRequest, "invalid private key")
+	resource.UnitTest(t, resource.TestCase{
+		Providers: f.providers(),
+		Steps: []resource.TestStep{
+			{
+				// container da tao phai duoc xoa khi tao secret loi
+				Config:      testAccELBCertificateConfig(f, cert, key, ""),
+				ExpectError: regexp.MustCompile("error creating ELB Certificate secrets: .*invalid private key"),
+			},
+		},
+		CheckDestroy: f.testCheckDestroyed("kmcontainer", "kmsecret"),
+	})
+}
+
+func testAccELBCertificateConfig(f *fakeCMCCloudAPI, cert string, key string, extra string) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_elb_certificate" "test" {
+  name        = "certificate-test"
+  certificate = %q
+  private_key = %q
+  %s
+}
+`, cert, key, extra)
+}
@@ -72,7 +72,7 @@ func resourceELBHealthMonitorCreate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return fmt.Errorf("error creating ELB HealthMonitor: %s", err)
 	}
-	return resourceELBHealthMonitorRead(d, meta)
+	return readWithRetry(resourceELBHealthMonitorRead, d, meta)
 }
 
 func resourceELBHealthMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -106,13 +106,13 @@ func resourceELBHealthMonitorUpdate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return fmt.Errorf("error updating ELB HealthMonitor: %s", err)
 	}
-	return resourceELBHealthMonitorRead(d, meta)
+	return readWithRetry(resourceELBHealthMonitorRead, d, meta)
 }
 
 func resourceELBHealthMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	healthmonitor, err := getClient(meta).ELB.GetHealthMonitor(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving ELBHealthMonitor %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving ELBHealthMonitor")
 	}
 	if len(healthmonitor.Pools) > 0 {
 		_ = d.Set("pool_id", healthmonitor.Pools[0].ID)
@@ -148,8 +148,7 @@ func resourceELBHealthMonitorDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceELBHealthMonitorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceELBHealthMonitorRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "pool_id", false, resourceELBHealthMonitorRead)
 }
 
 func waitUntilELBHealthMonitorDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -0,0 +1,256 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type elbL7Policy struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	ListenerID         string `json:"listener_id"`
+	Action             string `json:"action"`
+	Position           int    `json:"position"`
+	RedirectPoolID     string `json:"redirect_pool_id"`
+	RedirectURL        string `json:"redirect_url"`
+	RedirectPrefix     string `json:"redirect_prefix"`
+	RedirectHTTPCode   int    `json:"redirect_http_code"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+	CreatedAt          string `json:"created_at"`
+}
+
+func resourceELBL7Policy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceELBL7PolicyCreate,
+		Read:   resourceELBL7PolicyRead,
+		Update: resourceELBL7PolicyUpdate,
+		Delete: resourceELBL7PolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceELBL7PolicyImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        elbL7PolicySchema(),
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			action := diff.Get("action").(string)
+			if (action == "REDIRECT_TO_POOL") != isSet(diff, "redirect_pool_id") {
+				return fmt.Errorf("`redirect_pool_id` must be set when and only when `action` is REDIRECT_TO_POOL")
+			}
+			if (action == "REDIRECT_TO_URL") != isSet(diff, "redirect_url") {
+				return fmt.Errorf("`redirect_url` must be set when and only when `action` is REDIRECT_TO_URL")
+			}
+			if (action == "REDIRECT_PREFIX") != isSet(diff, "redirect_prefix") {
+				return fmt.Errorf("`redirect_prefix` must be set when and only when `action` is REDIRECT_PREFIX")
+			}
+			if action != "REDIRECT_TO_URL" && action != "REDIRECT_PREFIX" && isSet(diff, "redirect_http_code") {
+				return fmt.Errorf("`redirect_http_code` can be set only when `action` is REDIRECT_TO_URL or REDIRECT_PREFIX")
+			}
+			return nil
+		},
+	}
+}
+
+func resourceELBL7PolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	listener, err := getClient(meta).ELB.GetListener(d.Get("listener_id").(string))
+	if err != nil {
+		return fmt.Errorf("error receiving ELB Listener detail: %v", err)
+	}
+	if listener.Protocol != "HTTP" && listener.Protocol != "TERMINATED_HTTPS" {
+		return fmt.Errorf("L7 policy only avaiable for listener with protocol HTTP or TERMINATED_HTTPS, got %s", listener.Protocol)
+	}
+	if len(listener.Loadbalancers) == 0 {
+		return fmt.Errorf("listener %s is not attached to any ELB", listener.ID)
+	}
+	params := buildELBL7PolicyParams(d)
+	params["listener_id"] = d.Get("listener_id").(string)
+
+	// truoc khi tao l7 policy can doi ELB het pending
+	err = waitUntilELBEditable(listener.Loadbalancers[0].ID, d, meta)
+	if err != nil {
+		return err
+	}
+
+	policy, err := createELBL7Policy(meta, params)
+	if err != nil {
+		return fmt.Errorf("error creating ELB L7 Policy: %s", err)
+	}
+	d.SetId(policy.ID)
+	_, err = waitUntilELBL7PolicyStatusChangedState(d, meta, []string{"ONLINE", "ACTIVE", "OFFLINE", "NO_MONITOR"}, []string{"ERROR", "DELETED", "DEGRADED"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating ELB L7 Policy: %s", err)
+	}
+	return readWithRetry(resourceELBL7PolicyRead, d, meta)
+}
+
+func resourceELBL7PolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	elbId, err := getElbIdFromListener(meta, d.Get("listener_id").(string))
+	if err != nil {
+		return err
+	}
+	err = waitUntilELBEditable(elbId, d, meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = updateELBL7Policy(meta, d.Id(), buildELBL7PolicyParams(d))
+	if err != nil {
+		return fmt.Errorf("error update ELB L7 Policy: %s", err)
+	}
+	_, err = waitUntilELBL7PolicyStatusChangedState(d, meta, []string{"ONLINE", "ACTIVE", "OFFLINE", "NO_MONITOR"}, []string{"ERROR", "DELETED", "DEGRADED"}, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error update ELB L7 Policy: %s", err)
+	}
+	return readWithRetry(resourceELBL7PolicyRead, d, meta)
+}
+
+func buildELBL7PolicyParams(d *schema.ResourceData) map[string]interface{} {
+	action := d.Get("action").(string)
+	params := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"action":      action,
+	}
+	if v, ok := d.GetOk("position"); ok {
+		params["position"] = v.(int)
+	}
+	switch action {
+	case "REDIRECT_TO_POOL":
+		params["redirect_pool_id"] = d.Get("redirect_pool_id").(string)
+	case "REDIRECT_TO_URL":
+		params["redirect_url"] = d.Get("redirect_url").(string)
+	case "REDIRECT_PREFIX":
+		params["redirect_prefix"] = d.Get("redirect_prefix").(string)
+	}
+	if v, ok := d.GetOk("redirect_http_code"); ok && (action == "REDIRECT_TO_URL" || action == "REDIRECT_PREFIX") {
+		params["redirect_http_code"] = v.(int)
+	}
+	return params
+}
+
+func resourceELBL7PolicyRead(d *schema.ResourceData, meta interface{}) error {
+	policy, err := getELBL7Policy(meta, d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving ELB L7 Policy")
+	}
+
+	_ = d.Set("listener_id", policy.ListenerID)
+	_ = d.Set("name", policy.Name)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("action", policy.Action)
+	_ = d.Set("position", policy.Position)
+	_ = d.Set("redirect_pool_id", policy.RedirectPoolID)
+	_ = d.Set("redirect_url", policy.RedirectURL)
+	_ = d.Set("redirect_prefix", policy.RedirectPrefix)
+	// api tu dat redirect_http_code = 302 neu khong truyen len, chi luu lai khi nguoi dung co cau hinh
+	// de khong bi diff va xoa khi action khong con la redirect url/prefix
+	if policy.Action != "REDIRECT_TO_URL" && policy.Action != "REDIRECT_PREFIX" {
+		_ = d.Set("redirect_http_code", 0)
+	} else if _, ok := d.GetOk("redirect_http_code"); ok {
+		_ = d.Set("redirect_http_code", policy.RedirectHTTPCode)
+	}
+	_ = d.Set("created_at", policy.CreatedAt)
+	_ = d.Set("operating_status", policy.OperatingStatus)
+	_ = d.Set("provisioning_status", policy.ProvisioningStatus)
+	return nil
+}
+
+func resourceELBL7PolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	elbId, err := getElbIdFromListener(meta, d.Get("listener_id").(string))
+	if err != nil {
+		// listener da bi xoa (vd destroy cung luc listener & policy) thi policy cung da bi xoa theo
+		if errors.Is(err, gocmcapiv2.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	err = waitUntilELBEditable(elbId, d, meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = deleteELBL7Policy(meta, d.Id())
+	if err != nil {
+		if errors.Is(err, gocmcapiv2.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("error delete ELB L7 Policy: %v", err)
+	}
+	_, err = waitUntilELBL7PolicyDeleted(d, meta)
+	if err != nil {
+		return fmt.Errorf("error delete ELB L7 Policy: %v", err)
+	}
+	return nil
+}
+
+func resourceELBL7PolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	err := readWithRetry(resourceELBL7PolicyRead, d, meta)
+	return []*schema.ResourceData{d}, err
+}
+
+func waitUntilELBL7PolicyDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	return waitUntilResourceDeleted(d, meta, WaitConf{
+		Delay:      10 * time.Second,
+		MinTimeout: 20 * time.Second,
+	}, func(id string) (any, error) {
+		return getELBL7Policy(meta, id)
+	})
+}
+
+func waitUntilELBL7PolicyStatusChangedState(d *schema.ResourceData, meta interface{}, targetStatus []string, errorStatus []string, timeout time.Duration) (interface{}, error) {
+	return waitUntilResourceStatusChanged(d, meta, targetStatus, errorStatus, WaitConf{
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}, func(id string) (any, error) {
+		return getELBL7Policy(meta, id)
+	}, func(obj interface{}) string {
+		return obj.(elbL7Policy).ProvisioningStatus
+	})
+}
+
+func getElbIdFromListener(meta interface{}, listenerId string) (string, error) {
+	listener, err := getClient(meta).ELB.GetListener(listenerId)
+	if err != nil {
+		return "", fmt.Errorf("error receiving ELB Listener detail: %w", err)
+	}
+	if len(listener.Loadbalancers) == 0 {
+		return "", fmt.Errorf("listener %s is not attached to any ELB", listenerId)
+	}
+	return listener.Loadbalancers[0].ID, nil
+}
+
+// gocmcapiv2 chua ho tro l7 policy, goi truc tiep lbaas api
+func getELBL7Policy(meta interface{}, id string) (elbL7Policy, error) {
+	jsonStr, err := getClient(meta).Get("lbaas/l7policy/"+id, map[string]string{})
+	var policy elbL7Policy
+	if err == nil {
+		err = json.Unmarshal([]byte(jsonStr), &policy)
+	}
+	return policy, err
+}
+func createELBL7Policy(meta interface{}, params map[string]interface{}) (elbL7Policy, error) {
+	jsonStr, err := getClient(meta).Post("lbaas/l7policy", params)
+	var policy elbL7Policy
+	if err != nil {
+		return policy, err
+	}
+	err = json.Unmarshal([]byte(jsonStr), &policy)
+	return policy, err
+}
+func updateELBL7Policy(meta interface{}, id string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformUpdate("lbaas/l7policy/"+id, params)
+}
+func deleteELBL7Policy(meta interface{}, id string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("lbaas/l7policy/" + id)
+}
@@ -0,0 +1,110 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccELBL7Policy_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	subnetID := f.seedSubnet("10.31.1.0/24")
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("l7policy", "listener", "lbaas"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccELBL7PolicyConfig(f, subnetID, `
+  action             = "REDIRECT_TO_URL"
+  redirect_url       = "https://example.com"
+  redirect_http_code = 301
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cmccloudv2_elb_l7policy.test", "listener_id", "cmccloudv2_elb_listener.test", "id"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "action", "REDIRECT_TO_URL"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "redirect_url", "https://example.com"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "redirect_http_code", "301"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "position", "1"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "provisioning_status", "ACTIVE"),
+				),
+			},
+			{
+				// bo redirect khi doi action, redirect_http_code cu trong state khong duoc chan plan
+				Config: testAccELBL7PolicyConfig(f, subnetID, `
+  action = "REJECT"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "action", "REJECT"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "redirect_url", ""),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "redirect_http_code", "0"),
+					f.testCheckRequested("PUT", "lbaas/l7policy/{id}"),
+				),
+			},
+			{
+				// api tu dat redirect_http_code = 302, khong duoc gay diff khi nguoi dung khong cau hinh
+				Config: testAccELBL7PolicyConfig(f, subnetID, `
+  action          = "REDIRECT_PREFIX"
+  redirect_prefix = "https://example.org"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "action", "REDIRECT_PREFIX"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "redirect_prefix", "https://example.org"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "redirect_http_code", "0"),
+				),
+			},
+			{
+				Config: testAccELBL7PolicyConfig(f, subnetID, `
+  action             = "REDIRECT_TO_URL"
+  redirect_url       = "https://example.com"
+  redirect_http_code = 301
+`),
+				Check: resource.TestCheckResourceAttr("cmccloudv2_elb_l7policy.test", "redirect_http_code", "301"),
+			},
+			{
+				Config: testAccELBL7PolicyConfig(f, subnetID, `
+  action             = "REJECT"
+  redirect_http_code = 301
+`),
+				ExpectError: regexp.MustCompile("`redirect_http_code` can be set only when `action` is REDIRECT_TO_URL or REDIRECT_PREFIX"),
+			},
+			{
+				Config: testAccELBL7PolicyConfig(f, subnetID, `
+  action             = "REDIRECT_TO_URL"
+  redirect_url       = "https://example.com"
+  redirect_http_code = 301
+`),
+				ResourceName:      "cmccloudv2_elb_l7policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// api luon tra ve redirect_http_code, provider chi luu khi co trong cau hinh
+				ImportStateVerifyIgnore: []string{"redirect_http_code"},
+			},
+		},
+	})
+}
+
+func testAccELBL7PolicyConfig(f *fakeCMCCloudAPI, subnetID string, policy string) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_elb" "test" {
+  name         = "elb-l7policy-test"
+  zone         = "AZ1"
+  flavor_id    = %q
+  network_type = "private"
+  subnet_id    = %q
+}
+
+resource "cmccloudv2_elb_listener" "test" {
+  elb_id        = cmccloudv2_elb.test.id
+  name          = "listener-http"
+  protocol      = "HTTP"
+  protocol_port = 80
+}
+
+resource "cmccloudv2_elb_l7policy" "test" {
+  listener_id = cmccloudv2_elb_listener.test.id
+  name        = "policy-test"
+%s}
+`, testAccELBFlavorSmall, subnetID, policy)
+}
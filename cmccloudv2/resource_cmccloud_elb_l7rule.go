@@ -0,0 +1,213 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type elbL7Rule struct {
+	ID                 string `json:"id"`
+	Type               string `json:"type"`
+	CompareType        string `json:"compare_type"`
+	Key                string `json:"key"`
+	Value              string `json:"value"`
+	Invert             bool   `json:"invert"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+	CreatedAt          string `json:"created_at"`
+}
+
+func resourceELBL7Rule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceELBL7RuleCreate,
+		Read:   resourceELBL7RuleRead,
+		Update: resourceELBL7RuleUpdate,
+		Delete: resourceELBL7RuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceELBL7RuleImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        elbL7RuleSchema(),
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			_type := diff.Get("type").(string)
+			needKey := _type == "HEADER" || _type == "COOKIE" || _type == "SSL_DN_FIELD"
+			if needKey != isSet(diff, "key") {
+				return fmt.Errorf("`key` must be set when and only when `type` is HEADER, COOKIE or SSL_DN_FIELD")
+			}
+			return nil
+		},
+	}
+}
+
+func resourceELBL7RuleCreate(d *schema.ResourceData, meta interface{}) error {
+	policyId := d.Get("l7policy_id").(string)
+	elbId, err := getElbIdFromL7Policy(meta, policyId)
+	if err != nil {
+		return err
+	}
+
+	// truoc khi tao l7 rule can doi ELB het pending
+	err = waitUntilELBEditable(elbId, d, meta)
+	if err != nil {
+		return err
+	}
+
+	rule, err := createELBL7Rule(meta, policyId, buildELBL7RuleParams(d))
+	if err != nil {
+		return fmt.Errorf("error creating ELB L7 Rule: %s", err)
+	}
+	d.SetId(rule.ID)
+	_, err = waitUntilELBL7RuleStatusChangedState(d, meta, []string{"ONLINE", "ACTIVE", "OFFLINE", "NO_MONITOR"}, []string{"ERROR", "DELETED", "DEGRADED"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating ELB L7 Rule: %s", err)
+	}
+	return readWithRetry(resourceELBL7RuleRead, d, meta)
+}
+
+func resourceELBL7RuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	policyId := d.Get("l7policy_id").(string)
+	elbId, err := getElbIdFromL7Policy(meta, policyId)
+	if err != nil {
+		return err
+	}
+	err = waitUntilELBEditable(elbId, d, meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = updateELBL7Rule(meta, policyId, d.Id(), buildELBL7RuleParams(d))
+	if err != nil {
+		return fmt.Errorf("error update ELB L7 Rule: %s", err)
+	}
+	_, err = waitUntilELBL7RuleStatusChangedState(d, meta, []string{"ONLINE", "ACTIVE", "OFFLINE", "NO_MONITOR"}, []string{"ERROR", "DELETED", "DEGRADED"}, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error update ELB L7 Rule: %s", err)
+	}
+	return readWithRetry(resourceELBL7RuleRead, d, meta)
+}
+
+func buildELBL7RuleParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"type":         d.Get("type").(string),
+		"compare_type": d.Get("compare_type").(string),
+		"value":        d.Get("value").(string),
+		"invert":       d.Get("invert").(bool),
+	}
+	if v, ok := d.GetOk("key"); ok {
+		params["key"] = v.(string)
+	}
+	return params
+}
+
+func resourceELBL7RuleRead(d *schema.ResourceData, meta interface{}) error {
+	rule, err := getELBL7Rule(meta, d.Get("l7policy_id").(string), d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving ELB L7 Rule")
+	}
+
+	_ = d.Set("type", rule.Type)
+	_ = d.Set("compare_type", rule.CompareType)
+	_ = d.Set("key", rule.Key)
+	_ = d.Set("value", rule.Value)
+	_ = d.Set("invert", rule.Invert)
+	_ = d.Set("created_at", rule.CreatedAt)
+	_ = d.Set("operating_status", rule.OperatingStatus)
+	_ = d.Set("provisioning_status", rule.ProvisioningStatus)
+	return nil
+}
+
+func resourceELBL7RuleDelete(d *schema.ResourceData, meta interface{}) error {
+	policyId := d.Get("l7policy_id").(string)
+	elbId, err := getElbIdFromL7Policy(meta, policyId)
+	if err != nil {
+		// policy hoac listener da bi xoa thi rule cung da bi xoa theo
+		if errors.Is(err, gocmcapiv2.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	err = waitUntilELBEditable(elbId, d, meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = deleteELBL7Rule(meta, policyId, d.Id())
+	if err != nil {
+		if errors.Is(err, gocmcapiv2.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("error delete ELB L7 Rule: %v", err)
+	}
+	_, err = waitUntilELBL7RuleDeleted(d, meta)
+	if err != nil {
+		return fmt.Errorf("error delete ELB L7 Rule: %v", err)
+	}
+	return nil
+}
+
+func resourceELBL7RuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return importStateWithParentId(d, meta, "l7policy_id", true, resourceELBL7RuleRead)
+}
+
+func waitUntilELBL7RuleDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	return waitUntilResourceDeleted(d, meta, WaitConf{
+		Delay:      10 * time.Second,
+		MinTimeout: 20 * time.Second,
+	}, func(id string) (any, error) {
+		return getELBL7Rule(meta, d.Get("l7policy_id").(string), id)
+	})
+}
+
+func waitUntilELBL7RuleStatusChangedState(d *schema.ResourceData, meta interface{}, targetStatus []string, errorStatus []string, timeout time.Duration) (interface{}, error) {
+	return waitUntilResourceStatusChanged(d, meta, targetStatus, errorStatus, WaitConf{
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}, func(id string) (any, error) {
+		return getELBL7Rule(meta, d.Get("l7policy_id").(string), id)
+	}, func(obj interface{}) string {
+		return obj.(elbL7Rule).ProvisioningStatus
+	})
+}
+
+func getElbIdFromL7Policy(meta interface{}, policyId string) (string, error) {
+	policy, err := getELBL7Policy(meta, policyId)
+	if err != nil {
+		return "", fmt.Errorf("error receiving ELB L7 Policy detail: %w", err)
+	}
+	return getElbIdFromListener(meta, policy.ListenerID)
+}
+
+func getELBL7Rule(meta interface{}, policyId string, id string) (elbL7Rule, error) {
+	jsonStr, err := getClient(meta).Get("lbaas/l7policy/"+policyId+"/rule/"+id, map[string]string{})
+	var rule elbL7Rule
+	if err == nil {
+		err = json.Unmarshal([]byte(jsonStr), &rule)
+	}
+	return rule, err
+}
+func createELBL7Rule(meta interface{}, policyId string, params map[string]interface{}) (elbL7Rule, error) {
+	jsonStr, err := getClient(meta).Post("lbaas/l7policy/"+policyId+"/rule", params)
+	var rule elbL7Rule
+	if err != nil {
+		return rule, err
+	}
+	err = json.Unmarshal([]byte(jsonStr), &rule)
+	return rule, err
+}
+func updateELBL7Rule(meta interface{}, policyId string, id string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformUpdate("lbaas/l7policy/"+policyId+"/rule/"+id, params)
+}
+func deleteELBL7Rule(meta interface{}, policyId string, id string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("lbaas/l7policy/" + policyId + "/rule/" + id)
+}
@@ -87,7 +87,7 @@ func resourceELBListenerUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error update ELB Listener: %s", err)
 	}
-	return resourceELBListenerRead(d, meta)
+	return readWithRetry(resourceELBListenerRead, d, meta)
 }
 
 func resourceELBListenerCreate(d *schema.ResourceData, meta interface{}) error {
@@ -143,14 +143,14 @@ func resourceELBListenerCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error creating ELB Listener: %s", err)
 	}
-	return resourceELBListenerRead(d, meta)
+	return readWithRetry(resourceELBListenerRead, d, meta)
 }
 
 func resourceELBListenerRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	elblistener, err := client.ELB.GetListener(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving ELB Listener %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving ELB Listener")
 	}
 
 	if len(elblistener.Loadbalancers) > 0 {
@@ -206,7 +206,7 @@ func resourceELBListenerDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceELBListenerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceELBListenerRead(d, meta)
+	err := readWithRetry(resourceELBListenerRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -58,7 +58,7 @@ func resourceELBPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error updating ELB Pool: %s", err)
 	}
-	return resourceELBPoolRead(d, meta)
+	return readWithRetry(resourceELBPoolRead, d, meta)
 }
 
 func resourceELBPoolCreate(d *schema.ResourceData, meta interface{}) error {
@@ -82,14 +82,14 @@ func resourceELBPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error creating ELB Pool: %s", err)
 	}
-	return resourceELBPoolRead(d, meta)
+	return readWithRetry(resourceELBPoolRead, d, meta)
 }
 
 func resourceELBPoolRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	elbpool, err := client.ELB.GetPool(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving ELBPool %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving ELBPool")
 	}
 
 	if len(elbpool.Loadbalancers) > 0 {
@@ -128,7 +128,7 @@ func resourceELBPoolDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceELBPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceELBPoolRead(d, meta)
+	err := readWithRetry(resourceELBPoolRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -62,7 +62,7 @@ func resourceELBPoolMemberCreate(d *schema.ResourceData, meta interface{}) error
 	// if err != nil {
 	// 	return fmt.Errorf("Error creating ELB Pool Member: %s", err)
 	// }
-	return resourceELBPoolMemberRead(d, meta)
+	return readWithRetry(resourceELBPoolMemberRead, d, meta)
 }
 
 func resourceELBPoolMemberUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -85,14 +85,14 @@ func resourceELBPoolMemberUpdate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("error updating ELB Pool Member: %s", err)
 	}
-	return resourceELBPoolMemberRead(d, meta)
+	return readWithRetry(resourceELBPoolMemberRead, d, meta)
 }
 
 func resourceELBPoolMemberRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	member, err := client.ELB.GetPoolMember(d.Get("pool_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving ELB Pool Member %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving ELB Pool Member")
 	}
 
 	_ = d.Set("name", member.Name)
@@ -133,8 +133,7 @@ func resourceELBPoolMemberDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceELBPoolMemberImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceELBPoolMemberRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "pool_id", true, resourceELBPoolMemberRead)
 }
 
 func waitUntilELBPoolMemberDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -0,0 +1,215 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+const (
+	testAccELBFlavorSmall = "3b9d0f1a-0000-4000-8000-000000000001"
+	testAccELBFlavorLarge = "3b9d0f1a-0000-4000-8000-000000000002"
+)
+
+func TestAccELB_private(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	// subnet_id cua ELB private phai biet truoc khi plan nen dung subnet co san
+	subnetID := f.seedSubnet("10.30.1.0/24")
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("lbaas", "listener", "pool", "member", "healthmonitor"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccELBPrivateConfig(f, subnetID, testAccELBParams{
+					name: "elb-test", flavorID: testAccELBFlavorSmall, port: 80, algorithm: "ROUND_ROBIN", weight: 1, delay: 5,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_elb.test", "name", "elb-test"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb.test", "network_type", "private"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb.test", "subnet_id", subnetID),
+					resource.TestCheckResourceAttr("cmccloudv2_elb.test", "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair("cmccloudv2_elb_listener.test", "elb_id", "cmccloudv2_elb.test", "id"),
+					resource.TestCheckResourceAttrPair("cmccloudv2_elb_listener.test", "default_pool_id", "cmccloudv2_elb_pool.test", "id"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_listener.test", "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_pool.test", "algorithm", "ROUND_ROBIN"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_pool_member.test", "weight", "1"),
+					resource.TestCheckResourceAttrPair("cmccloudv2_elb_healthmonitor.test", "pool_id", "cmccloudv2_elb_pool.test", "id"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_healthmonitor.test", "provisioning_status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccELBPrivateConfig(f, subnetID, testAccELBParams{
+					name: "elb-test-renamed", flavorID: testAccELBFlavorLarge, port: 80, algorithm: "LEAST_CONNECTIONS", weight: 10, delay: 10,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_elb.test", "name", "elb-test-renamed"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb.test", "flavor_id", testAccELBFlavorLarge),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_listener.test", "name", "listener-elb-test-renamed"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_pool.test", "algorithm", "LEAST_CONNECTIONS"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_pool_member.test", "weight", "10"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb_healthmonitor.test", "delay", "10"),
+					f.testCheckRequested("POST", "lbaas/{id}/resize"),
+				),
+			},
+			{
+				Config: testAccELBPrivateConfig(f, subnetID, testAccELBParams{
+					name: "elb-test-renamed", flavorID: testAccELBFlavorLarge, port: 80, algorithm: "LEAST_CONNECTIONS", weight: 10, delay: 10,
+				}),
+				ResourceName:      "cmccloudv2_elb.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// ELB chuyen PENDING_UPDATE moi khi listener/pool/member thay doi, trang thai trong state co the da cu
+				ImportStateVerifyIgnore: []string{"provisioning_status"},
+			},
+			{
+				Config: testAccELBPrivateConfig(f, subnetID, testAccELBParams{
+					name: "elb-test-renamed", flavorID: testAccELBFlavorLarge, port: 80, algorithm: "LEAST_CONNECTIONS", weight: 10, delay: 10,
+				}),
+				ResourceName:      "cmccloudv2_elb_listener.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// api chi tra ve cac gia tri timeout/connection_limit, provider khong set lai khi import
+				ImportStateVerifyIgnore: []string{"connection_limit", "timeout_member_connect", "timeout_member_data"},
+			},
+			{
+				Config: testAccELBPrivateConfig(f, subnetID, testAccELBParams{
+					name: "elb-test-renamed", flavorID: testAccELBFlavorLarge, port: 80, algorithm: "LEAST_CONNECTIONS", weight: 10, delay: 10,
+				}),
+				ResourceName:            "cmccloudv2_elb_pool.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"session_persistence"},
+			},
+			{
+				Config: testAccELBPrivateConfig(f, subnetID, testAccELBParams{
+					name: "elb-test-renamed", flavorID: testAccELBFlavorLarge, port: 80, algorithm: "LEAST_CONNECTIONS", weight: 10, delay: 10,
+				}),
+				ResourceName:      "cmccloudv2_elb_pool_member.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccELBPoolMemberImportID("cmccloudv2_elb_pool_member.test"),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccELBPrivateConfig(f, subnetID, testAccELBParams{
+					name: "elb-test-renamed", flavorID: testAccELBFlavorLarge, port: 80, algorithm: "LEAST_CONNECTIONS", weight: 10, delay: 10,
+				}),
+				ResourceName:      "cmccloudv2_elb_healthmonitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccELB_public(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("lbaas"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccELBPublicConfig(f, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_elb.test", "network_type", "public"),
+					resource.TestCheckResourceAttr("cmccloudv2_elb.test", "bandwidth_mbps", "100"),
+				),
+			},
+			{
+				Config: testAccELBPublicConfig(f, 200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_elb.test", "bandwidth_mbps", "200"),
+					f.testCheckRequested("POST", "lbaas/{id}/resize"),
+				),
+			},
+			{
+				Config:            testAccELBPublicConfig(f, 200),
+				ResourceName:      "cmccloudv2_elb.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccELBPoolMemberImportID tra ve import id dang <pool_id>/<id> cua pool member
+func testAccELBPoolMemberImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+		return rs.Primary.Attributes["pool_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+type testAccELBParams struct {
+	name      string
+	flavorID  string
+	port      int
+	algorithm string
+	weight    int
+	delay     int
+}
+
+func testAccELBPrivateConfig(f *fakeCMCCloudAPI, subnetID string, p testAccELBParams) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_elb" "test" {
+  name         = %[1]q
+  description  = "elb test"
+  zone         = "AZ1"
+  flavor_id    = %[2]q
+  network_type = "private"
+  subnet_id    = %[7]q
+  tags         = ["env:test"]
+}
+
+resource "cmccloudv2_elb_pool" "test" {
+  elb_id              = cmccloudv2_elb.test.id
+  name                = "pool-%[1]s"
+  protocol            = "TCP"
+  algorithm           = %[4]q
+  session_persistence = "SOURCE_IP"
+}
+
+resource "cmccloudv2_elb_listener" "test" {
+  elb_id          = cmccloudv2_elb.test.id
+  name            = "listener-%[1]s"
+  protocol        = "TCP"
+  protocol_port   = %[3]d
+  default_pool_id = cmccloudv2_elb_pool.test.id
+}
+
+resource "cmccloudv2_elb_pool_member" "test" {
+  pool_id       = cmccloudv2_elb_pool.test.id
+  name          = "member-%[1]s"
+  address       = "10.30.1.50"
+  protocol_port = 8080
+  weight        = %[5]d
+  subnet_id     = %[7]q
+}
+
+resource "cmccloudv2_elb_healthmonitor" "test" {
+  pool_id          = cmccloudv2_elb_pool.test.id
+  name             = "monitor-%[1]s"
+  type             = "TCP"
+  delay            = %[6]d
+  timeout          = 3
+  max_retries      = 3
+  max_retries_down = 3
+}
+`, p.name, p.flavorID, p.port, p.algorithm, p.weight, p.delay, subnetID)
+}
+
+func testAccELBPublicConfig(f *fakeCMCCloudAPI, bandwidth int) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_elb" "test" {
+  name           = "elb-public-test"
+  zone           = "AZ1"
+  flavor_id      = %q
+  network_type   = "public"
+  bandwidth_mbps = %d
+}
+`, testAccELBFlavorSmall, bandwidth)
+}
@@ -0,0 +1,384 @@
+package cmccloudv2
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type imageDetail struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Status          string   `json:"status"`
+	Visibility      string   `json:"visibility"`
+	DiskFormat      string   `json:"disk_format"`
+	ContainerFormat string   `json:"container_format"`
+	MinDisk         int      `json:"min_disk"`
+	MinRAM          int      `json:"min_ram"`
+	Size            int64    `json:"size"`
+	Checksum        string   `json:"checksum"`
+	Os              string   `json:"os"`
+	Tags            []string `json:"tags"`
+	CreatedAt       string   `json:"created_at"`
+}
+
+type imageMember struct {
+	MemberID string `json:"member_id"`
+	Status   string `json:"status"`
+}
+
+func resourceImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImageCreate,
+		Read:   resourceImageRead,
+		Update: resourceImageUpdate,
+		Delete: resourceImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceImageImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        imageSchema(),
+		CustomizeDiff: customdiff.All(
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				sources := 0
+				for _, key := range []string{"local_file_path", "server_id", "volume_id"} {
+					if isSet(diff, key) {
+						sources++
+					}
+				}
+				// resource import khong co source, chi kiem tra khi tao moi
+				if diff.Id() == "" && sources != 1 {
+					return fmt.Errorf("exactly one of `local_file_path`, `server_id` or `volume_id` must be set")
+				}
+				if diff.Get("visibility").(string) != "shared" && diff.Get("shared_project_ids").(*schema.Set).Len() > 0 {
+					return fmt.Errorf("`shared_project_ids` can be set only when `visibility` is shared")
+				}
+				return nil
+			},
+			customizeDiffTagsAll,
+		),
+	}
+}
+
+func resourceImageCreate(d *schema.ResourceData, meta interface{}) error {
+	var localChecksum string
+	if v, ok := d.GetOk("local_file_path"); ok {
+		path, err := expandHomeDir(v.(string))
+		if err != nil {
+			return err
+		}
+		localChecksum, err = fileMD5Checksum(path)
+		if err != nil {
+			return fmt.Errorf("error reading image file: %v", err)
+		}
+		if v, ok := d.GetOk("checksum"); ok && !strings.EqualFold(v.(string), localChecksum) {
+			return fmt.Errorf("checksum of %s is %s, expected %s", path, localChecksum, v.(string))
+		}
+
+		params := buildImageParams(d, meta)
+		params["disk_format"] = d.Get("disk_format").(string)
+		params["container_format"] = "bare"
+		image, err := createImage(meta, params)
+		if err != nil {
+			return fmt.Errorf("error creating image: %v", err)
+		}
+		d.SetId(image.ID)
+
+		ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		err = uploadImageFile(ctx, meta, image.ID, path)
+		if err != nil {
+			return fmt.Errorf("error uploading image file: %v", err)
+		}
+	} else {
+		var imageId string
+		var err error
+		params := map[string]interface{}{
+			"name":        d.Get("name").(string),
+			"disk_format": d.Get("disk_format").(string),
+		}
+		if v, ok := d.GetOk("server_id"); ok {
+			imageId, err = createImageFromServer(meta, v.(string), params)
+		} else {
+			imageId, err = createImageFromVolume(meta, d.Get("volume_id").(string), params)
+		}
+		if err != nil {
+			return fmt.Errorf("error creating image: %v", err)
+		}
+		d.SetId(imageId)
+	}
+
+	_, err := waitUntilImageStatusChangedState(d, meta, []string{"active"}, []string{"killed", "deleted", "deactivated"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating image: %v", err)
+	}
+
+	image, err := getImage(meta, d.Id())
+	if err != nil {
+		return fmt.Errorf("error retrieving image: %v", err)
+	}
+	if localChecksum != "" && image.Checksum != "" && !strings.EqualFold(image.Checksum, localChecksum) {
+		return fmt.Errorf("checksum of uploaded image is %s, expected %s", image.Checksum, localChecksum)
+	}
+
+	// image tao tu server/volume chua co visibility, tags... can update lai
+	if localChecksum == "" {
+		_, err = updateImage(meta, d.Id(), buildImageParams(d, meta))
+		if err != nil {
+			return fmt.Errorf("error updating image: %v", err)
+		}
+	}
+
+	for _, projectId := range getStringArrayFromTypeSet(d.Get("shared_project_ids").(*schema.Set)) {
+		_, err = addImageMember(meta, d.Id(), projectId)
+		if err != nil {
+			return fmt.Errorf("error sharing image with project %s: %v", projectId, err)
+		}
+	}
+	return readWithRetry(resourceImageRead, d, meta)
+}
+
+func buildImageParams(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	params := map[string]interface{}{
+		"name":       d.Get("name").(string),
+		"visibility": d.Get("visibility").(string),
+		"tags":       getTagsWithDefault(d, meta),
+	}
+	if v, ok := d.GetOk("min_disk"); ok {
+		params["min_disk"] = v.(int)
+	}
+	if v, ok := d.GetOk("min_ram"); ok {
+		params["min_ram"] = v.(int)
+	}
+	return params
+}
+
+func resourceImageRead(d *schema.ResourceData, meta interface{}) error {
+	image, err := getImage(meta, d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving image")
+	}
+
+	_ = d.Set("name", image.Name)
+	_ = d.Set("disk_format", image.DiskFormat)
+	_ = d.Set("min_disk", image.MinDisk)
+	_ = d.Set("min_ram", image.MinRAM)
+	_ = d.Set("visibility", image.Visibility)
+	_ = d.Set("checksum", image.Checksum)
+	_ = d.Set("status", image.Status)
+	_ = d.Set("size", image.Size)
+	_ = d.Set("os", image.Os)
+	_ = d.Set("created_at", image.CreatedAt)
+	setTagsWithDefault(d, meta, image.Tags)
+
+	projectIds := make([]string, 0)
+	if image.Visibility == "shared" {
+		members, err := getImageMembers(meta, d.Id())
+		if err != nil {
+			return fmt.Errorf("error retrieving image members: %v", err)
+		}
+		for _, member := range members {
+			projectIds = append(projectIds, member.MemberID)
+		}
+	}
+	_ = d.Set("shared_project_ids", projectIds)
+	return nil
+}
+
+func resourceImageUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("name", "min_disk", "min_ram", "visibility", "tags", "tags_all") {
+		_, err := updateImage(meta, d.Id(), buildImageParams(d, meta))
+		if err != nil {
+			return fmt.Errorf("error updating image [%s]: %v", d.Id(), err)
+		}
+	}
+	if d.HasChange("shared_project_ids") {
+		removes, adds := getDiffSet(d.GetChange("shared_project_ids"))
+		for _, projectId := range removes.List() {
+			_, err := removeImageMember(meta, d.Id(), projectId.(string))
+			if err != nil && !errors.Is(err, gocmcapiv2.ErrNotFound) {
+				return fmt.Errorf("error unsharing image with project %s: %v", projectId, err)
+			}
+		}
+		for _, projectId := range adds.List() {
+			_, err := addImageMember(meta, d.Id(), projectId.(string))
+			if err != nil {
+				return fmt.Errorf("error sharing image with project %s: %v", projectId, err)
+			}
+		}
+	}
+	return readWithRetry(resourceImageRead, d, meta)
+}
+
+func resourceImageDelete(d *schema.ResourceData, meta interface{}) error {
+	_, err := deleteImage(meta, d.Id())
+	if err != nil {
+		return fmt.Errorf("error delete image [%s]: %v", d.Id(), err)
+	}
+	_, err = waitUntilResourceDeleted(d, meta, WaitConf{
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}, func(id string) (any, error) {
+		return getImage(meta, id)
+	})
+	if err != nil {
+		return fmt.Errorf("error delete image [%s]: %v", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceImageImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	err := readWithRetry(resourceImageRead, d, meta)
+	return []*schema.ResourceData{d}, err
+}
+
+func waitUntilImageStatusChangedState(d *schema.ResourceData, meta interface{}, targetStatus []string, errorStatus []string, timeout time.Duration) (interface{}, error) {
+	return waitUntilResourceStatusChanged(d, meta, targetStatus, errorStatus, WaitConf{
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 20 * time.Second,
+	}, func(id string) (any, error) {
+		return getImage(meta, id)
+	}, func(obj interface{}) string {
+		return obj.(imageDetail).Status
+	})
+}
+
+func fileMD5Checksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// uploadImageFile upload du lieu image dang stream, gocmcapiv2 chi ho tro request json nen goi truc tiep http
+func uploadImageFile(ctx context.Context, meta interface{}, id string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	configs := getClient(meta).Configs
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, configs.APIEndpoint+"/image/"+id+"/file", file)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = info.Size()
+	query := req.URL.Query()
+	query.Set("api_key", configs.APIKey)
+	req.URL.RawQuery = query.Encode()
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Project-Id", configs.ProjectId)
+	req.Header.Set("Region-Id", configs.RegionId)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		var apiError gocmcapiv2.APIError
+		if json.Unmarshal(body, &apiError) == nil && apiError.Error.ErrorText != "" {
+			return fmt.Errorf("Error %d: %s", resp.StatusCode, apiError.Error.ErrorText)
+		}
+		return fmt.Errorf("Error %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// gocmcapiv2 chi ho tro get/list image, goi truc tiep image api
+func getImage(meta interface{}, id string) (imageDetail, error) {
+	jsonStr, err := getClient(meta).Get("image/"+id, map[string]string{})
+	var image imageDetail
+	if err == nil {
+		err = json.Unmarshal([]byte(jsonStr), &image)
+	}
+	return image, err
+}
+func createImage(meta interface{}, params map[string]interface{}) (imageDetail, error) {
+	jsonStr, err := getClient(meta).Post("image", params)
+	var image imageDetail
+	if err != nil {
+		return image, err
+	}
+	err = json.Unmarshal([]byte(jsonStr), &image)
+	return image, err
+}
+func createImageFromServer(meta interface{}, serverId string, params map[string]interface{}) (string, error) {
+	return parseCreatedImageId(getClient(meta).Post("server/"+serverId+"/create_image", params))
+}
+func createImageFromVolume(meta interface{}, volumeId string, params map[string]interface{}) (string, error) {
+	return parseCreatedImageId(getClient(meta).Post("volume/"+volumeId+"/upload_to_image", params))
+}
+func parseCreatedImageId(jsonStr string, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	var response struct {
+		ImageID string `json:"image_id"`
+		ID      string `json:"id"`
+	}
+	err = json.Unmarshal([]byte(jsonStr), &response)
+	if err != nil {
+		return "", err
+	}
+	if response.ImageID != "" {
+		return response.ImageID, nil
+	}
+	if response.ID != "" {
+		return response.ID, nil
+	}
+	return "", fmt.Errorf("image id not found in response: %s", jsonStr)
+}
+func updateImage(meta interface{}, id string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformUpdate("image/"+id, params)
+}
+func deleteImage(meta interface{}, id string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("image/" + id)
+}
+func getImageMembers(meta interface{}, id string) ([]imageMember, error) {
+	jsonStr, err := getClient(meta).Get("image/"+id+"/members", map[string]string{})
+	members := make([]imageMember, 0)
+	if err == nil {
+		err = json.Unmarshal([]byte(jsonStr), &members)
+	}
+	return members, err
+}
+func addImageMember(meta interface{}, id string, projectId string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformAction("image/"+id+"/members", map[string]interface{}{
+		"member_id": projectId,
+	})
+}
+func removeImageMember(meta interface{}, id string, projectId string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("image/" + id + "/members/" + projectId)
+}
@@ -0,0 +1,165 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccImageProjectA = "0fa4e000-0000-4000-8000-00000000aaaa"
+	testAccImageProjectB = "0fa4e000-0000-4000-8000-00000000bbbb"
+)
+
+func TestAccImage_localFile(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	path := filepath.Join(t.TempDir(), "image.qcow2")
+	if err := os.WriteFile(path, []byte("fake qcow2 image data"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	checksum, err := fileMD5Checksum(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("image"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig(f, "image-upload", fmt.Sprintf(`
+  local_file_path    = %q
+  checksum           = %q
+  min_disk           = 10
+  visibility         = "shared"
+  shared_project_ids = [%q]
+  tags               = ["env:test"]
+`, path, checksum, testAccImageProjectA)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "status", "active"),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "checksum", checksum),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "size", "21"),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "disk_format", "qcow2"),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "min_disk", "10"),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "shared_project_ids.#", "1"),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "tags.#", "1"),
+					f.testCheckRequestCount("PUT", "image/{id}/file", 1),
+				),
+			},
+			{
+				// doi ten & danh sach project duoc chia se, khong upload lai
+				Config: testAccImageConfig(f, "image-upload-renamed", fmt.Sprintf(`
+  local_file_path    = %q
+  checksum           = %q
+  min_disk           = 10
+  visibility         = "shared"
+  shared_project_ids = [%q]
+  tags               = ["env:test"]
+`, path, checksum, testAccImageProjectB)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "name", "image-upload-renamed"),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "shared_project_ids.#", "1"),
+					f.testCheckObject("image", "members", []interface{}{testAccImageProjectB}),
+					f.testCheckRequestCount("PUT", "image/{id}/file", 1),
+				),
+			},
+			{
+				Config: testAccImageConfig(f, "image-upload-renamed", fmt.Sprintf(`
+  local_file_path    = %q
+  checksum           = %q
+  min_disk           = 10
+  visibility         = "shared"
+  shared_project_ids = [%q]
+  tags               = ["env:test"]
+`, path, checksum, testAccImageProjectB)),
+				ResourceName:      "cmccloudv2_image.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// nguon tao image khong luu tren api
+				ImportStateVerifyIgnore: []string{"local_file_path"},
+			},
+		},
+	})
+}
+
+func TestAccImage_volume(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	volumeID := f.seedVolume(20)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("image"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig(f, "image-volume", fmt.Sprintf(`
+  volume_id = %q
+  min_ram   = 1024
+  tags      = ["env:test"]
+`, volumeID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "status", "active"),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "visibility", "private"),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "size", fmt.Sprint(20<<30)),
+					// api tao image tu volume chi nhan name & disk_format, min_ram & tags duoc update sau
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "min_ram", "1024"),
+					resource.TestCheckResourceAttr("cmccloudv2_image.test", "tags.#", "1"),
+					f.testCheckRequested("PUT", "image/{id}"),
+				),
+			},
+			{
+				Config: testAccImageConfig(f, "image-volume", fmt.Sprintf(`
+  volume_id = %q
+  min_ram   = 1024
+  tags      = ["env:test"]
+`, volumeID)),
+				ResourceName:            "cmccloudv2_image.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"volume_id"},
+			},
+		},
+	})
+}
+
+func TestAccImage_invalid(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	path := filepath.Join(t.TempDir(), "image.raw")
+	if err := os.WriteFile(path, []byte("fake raw image data"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("image"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccImageConfig(f, "image-no-source", ""),
+				ExpectError: regexp.MustCompile("exactly one of `local_file_path`, `server_id` or `volume_id` must be set"),
+			},
+			{
+				Config: testAccImageConfig(f, "image-private-shared", fmt.Sprintf(`
+  local_file_path    = %q
+  shared_project_ids = [%q]
+`, path, testAccImageProjectA)),
+				ExpectError: regexp.MustCompile("`shared_project_ids` can be set only when `visibility` is shared"),
+			},
+			{
+				// file local khong khop checksum thi khong tao image
+				Config: testAccImageConfig(f, "image-bad-checksum", fmt.Sprintf(`
+  local_file_path = %q
+  checksum        = "00000000000000000000000000000000"
+`, path)),
+				ExpectError: regexp.MustCompile("checksum of .* is [0-9a-f]{32}, expected 0{32}"),
+			},
+		},
+	})
+}
+
+func testAccImageConfig(f *fakeCMCCloudAPI, name string, extra string) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_image" "test" {
+  name = %q
+%s}
+`, name, extra)
+}
@@ -39,13 +39,13 @@ func resourceKeyManagementContainerCreate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error creating KeyManagementContainer: %s", err)
 	}
 	d.SetId(container.Data.ID)
-	return resourceKeyManagementContainerRead(d, meta)
+	return readWithRetry(resourceKeyManagementContainerRead, d, meta)
 }
 
 func resourceKeyManagementContainerRead(d *schema.ResourceData, meta interface{}) error {
 	container, err := getClient(meta).KeyManagement.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving KeyManagement Container %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving KeyManagement Container")
 	}
 
 	_ = d.Set("name", container.Name)
@@ -63,7 +63,7 @@ func resourceKeyManagementContainerDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceKeyManagementContainerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceKeyManagementContainerRead(d, meta)
+	err := readWithRetry(resourceKeyManagementContainerRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
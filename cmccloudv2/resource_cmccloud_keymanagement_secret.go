@@ -47,13 +47,13 @@ func resourceKeyManagementSecretCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error creating KeyManagement Secret: %s", err)
 	}
 	d.SetId(secret.Data.Secrets[0].ID)
-	return resourceKeyManagementSecretRead(d, meta)
+	return readWithRetry(resourceKeyManagementSecretRead, d, meta)
 }
 
 func resourceKeyManagementSecretRead(d *schema.ResourceData, meta interface{}) error {
 	container, err := getClient(meta).KeyManagement.GetSecret(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving KeyManagement Secret %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving KeyManagement Secret")
 	}
 
 	_ = d.Set("name", container.Name)
@@ -74,7 +74,7 @@ func resourceKeyManagementSecretDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKeyManagementSecretImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceKeyManagementSecretRead(d, meta)
+	err := readWithRetry(resourceKeyManagementSecretRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -71,7 +71,7 @@ func resourceKeyManagementTokenUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("error renewing KeyManagement Token %s: %v", d.Id(), err)
 		}
 	}
-	return resourceKeyManagementTokenRead(d, meta)
+	return readWithRetry(resourceKeyManagementTokenRead, d, meta)
 }
 
 func resourceKeyManagementTokenCreate(d *schema.ResourceData, meta interface{}) error {
@@ -96,13 +96,13 @@ func resourceKeyManagementTokenCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error creating KeyManagement Token: %s", err)
 	}
 	d.SetId(token.Data.ID)
-	return resourceKeyManagementTokenRead(d, meta)
+	return readWithRetry(resourceKeyManagementTokenRead, d, meta)
 }
 
 func resourceKeyManagementTokenRead(d *schema.ResourceData, meta interface{}) error {
 	token, err := getClient(meta).KeyManagement.GetToken(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving KeyManagement Token %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving KeyManagement Token")
 	}
 
 	_ = d.Set("token", token.Token)
@@ -122,7 +122,7 @@ func resourceKeyManagementTokenDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKeyManagementTokenImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceKeyManagementTokenRead(d, meta)
+	err := readWithRetry(resourceKeyManagementTokenRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
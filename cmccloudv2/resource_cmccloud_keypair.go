@@ -0,0 +1,142 @@
+package cmccloudv2
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKeypair() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeypairCreate,
+		Read:   resourceKeypairRead,
+		Delete: resourceKeypairDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceKeypairImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        keypairSchema(),
+	}
+}
+
+func resourceKeypairCreate(d *schema.ResourceData, meta interface{}) error {
+	publicKey := strings.TrimSpace(d.Get("public_key").(string))
+	if publicKey == "" {
+		// tu sinh keypair o local, private key chi luu trong state, khong gui len api
+		privateKey, generatedPublicKey, err := generateSSHKeypair()
+		if err != nil {
+			return fmt.Errorf("error generating keypair: %v", err)
+		}
+		publicKey = generatedPublicKey
+		_ = d.Set("private_key", privateKey)
+	}
+
+	keypair, err := createKeypair(meta, map[string]interface{}{
+		"name":       d.Get("name").(string),
+		"public_key": publicKey,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating keypair: %v", err)
+	}
+	d.SetId(keypair.Name)
+	return readWithRetry(resourceKeypairRead, d, meta)
+}
+
+func resourceKeypairRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	keypair, err := client.Keypair.Get(d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving keypair")
+	}
+	_ = d.Set("name", keypair.Name)
+	_ = d.Set("public_key", keypair.PublicKey)
+	_ = d.Set("fingerprint", keypair.Fingerprint)
+	_ = d.Set("type", keypair.Type)
+	return nil
+}
+
+func resourceKeypairDelete(d *schema.ResourceData, meta interface{}) error {
+	_, err := deleteKeypair(meta, d.Id())
+	if err != nil {
+		return fmt.Errorf("error delete keypair [%s]: %v", d.Id(), err)
+	}
+	_, err = waitUntilResourceDeleted(d, meta, WaitConf{
+		Delay:      3 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}, func(id string) (any, error) {
+		return getClient(meta).Keypair.Get(id)
+	})
+	if err != nil {
+		return fmt.Errorf("error delete keypair [%s]: %v", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceKeypairImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	err := readWithRetry(resourceKeypairRead, d, meta)
+	return []*schema.ResourceData{d}, err
+}
+
+// generateSSHKeypair sinh RSA 4096 keypair, tra ve private key dang PEM va public key dang OpenSSH authorized_keys
+func generateSSHKeypair() (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 4096)
+	if err != nil {
+		return "", "", err
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	// dinh dang ssh-rsa theo RFC 4253: string "ssh-rsa", mpint e, mpint n
+	var buf []byte
+	writeString := func(b []byte) {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(b)))
+		buf = append(buf, b...)
+	}
+	writeMpint := func(n *big.Int) {
+		b := n.Bytes()
+		if len(b) > 0 && b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		writeString(b)
+	}
+	writeString([]byte("ssh-rsa"))
+	writeMpint(big.NewInt(int64(key.PublicKey.E)))
+	writeMpint(key.PublicKey.N)
+	publicKey := "ssh-rsa " + base64.StdEncoding.EncodeToString(buf)
+
+	return string(privateKey), publicKey, nil
+}
+
+// gocmcapiv2 chua ho tro tao/xoa keypair, goi truc tiep keypair api
+func createKeypair(meta interface{}, params map[string]interface{}) (gocmcapiv2.Keypair, error) {
+	jsonStr, err := getClient(meta).Post("keypair", params)
+	var keypair gocmcapiv2.Keypair
+	if err != nil {
+		return keypair, err
+	}
+	err = json.Unmarshal([]byte(jsonStr), &keypair)
+	if err == nil && keypair.Name == "" {
+		keypair.Name = params["name"].(string)
+	}
+	return keypair, err
+}
+func deleteKeypair(meta interface{}, name string) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformDelete("keypair/" + name)
+}
@@ -113,14 +113,14 @@ func resourceKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error creating Kubernetes: %s", err)
 	}
-	return resourceKubernetesRead(d, meta)
+	return readWithRetry(resourceKubernetesRead, d, meta)
 }
 
 func resourceKubernetesRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	kubernetes, err := client.Kubernetes.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Kubernetes %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Kubernetes")
 	}
 
 	labels := make([]map[string]interface{}, 1)
@@ -288,7 +288,7 @@ func resourceKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return resourceKubernetesRead(d, meta)
+	return readWithRetry(resourceKubernetesRead, d, meta)
 }
 
 func resourceKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
@@ -306,7 +306,7 @@ func resourceKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKubernetesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceKubernetesRead(d, meta)
+	err := readWithRetry(resourceKubernetesRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
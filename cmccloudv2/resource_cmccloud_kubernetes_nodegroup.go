@@ -56,14 +56,14 @@ func resourceKubernetesNodeGroupCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error creating Kubernetes NodeGroup: %v", err)
 	}
 
-	return resourceKubernetesNodeGroupRead(d, meta)
+	return readWithRetry(resourceKubernetesNodeGroupRead, d, meta)
 }
 
 func resourceKubernetesNodeGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	nodegroup, err := client.Kubernetes.GetNodeGroup(d.Get("cluster_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Kubernetes NodeGroup %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Kubernetes NodeGroup")
 	}
 
 	_ = d.Set("id", nodegroup.ID)
@@ -119,7 +119,7 @@ func resourceKubernetesNodeGroupUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("error when change min_node_count/max_node_count of Kubernetes NodeGroup [%s]: %v", id, err)
 		}
 	}
-	return resourceKubernetesNodeGroupRead(d, meta)
+	return readWithRetry(resourceKubernetesNodeGroupRead, d, meta)
 }
 
 func resourceKubernetesNodeGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -137,8 +137,7 @@ func resourceKubernetesNodeGroupDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesNodeGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceKubernetesNodeGroupRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "cluster_id", true, resourceKubernetesNodeGroupRead)
 }
 
 func waitUntilKubernetesNodeGroupDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -1,11 +1,17 @@
 package cmccloudv2
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cmc-cloud/gocmcapiv2"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"gopkg.in/yaml.v3"
 )
 
 func resourceKubernetesv2() *schema.Resource {
@@ -35,6 +41,12 @@ func resourceKubernetesv2() *schema.Resource {
 					return fmt.Errorf("when `enable_autoscale` is 'false', `autoscale_max_node, autoscale_max_ram_gb, autoscale_max_core must not be set")
 				}
 			}
+			if diff.Id() != "" && diff.HasChange("kubernetes_version") {
+				oldVersion, newVersion := diff.GetChange("kubernetes_version")
+				if isKubernetesVersionDowngrade(oldVersion.(string), newVersion.(string)) {
+					return fmt.Errorf("can't downgrade kubernetes_version from %s to %s", oldVersion.(string), newVersion.(string))
+				}
+			}
 			return nil
 		},
 	}
@@ -46,6 +58,11 @@ func resourceKubernetesv2Create(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("error receving subnet with id = %s: %v", d.Get("subnet_id").(string), err)
 	}
+	rolloutStrategyType, rolloutStrategyMaxSurge := "", ""
+	if strategy := getFirstBlock(d, "rollout_strategy"); strategy != nil {
+		rolloutStrategyType = "RollingUpdate"
+		rolloutStrategyMaxSurge = strconv.Itoa(strategy["max_surge"].(int))
+	}
 	kubernetes, err := client.Kubernetesv2.Create(map[string]interface{}{
 		"region":         client.Configs.RegionId,
 		"project":        client.Configs.ProjectId,
@@ -62,8 +79,8 @@ func resourceKubernetesv2Create(d *schema.ResourceData, meta interface{}) error
 		"clusterNetworkServiceDomain":      d.Get("network_driver").(string),
 		"clusterNetworkServicesCidrBlocks": "",
 		"clusterNetworkApiServerPort":      "",
-		"rolloutStrategyType":              "",
-		"rolloutStrategyMaxSurge":          "",
+		"rolloutStrategyType":              rolloutStrategyType,
+		"rolloutStrategyMaxSurge":          rolloutStrategyMaxSurge,
 	})
 
 	if err != nil {
@@ -94,14 +111,14 @@ func resourceKubernetesv2Create(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceKubernetesv2Read(d, meta)
+	return readWithRetry(resourceKubernetesv2Read, d, meta)
 }
 
 func resourceKubernetesv2Read(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	kubernetes, err := client.Kubernetesv2.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Kubernetesv2 %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Kubernetesv2")
 	}
 
 	_ = d.Set("id", kubernetes.ClusterID)
@@ -124,6 +141,20 @@ func resourceKubernetesv2Read(d *schema.ResourceData, meta interface{}) error {
 	_ = d.Set("enable_autoscale", status.EnableAutoScale)
 	_ = d.Set("enable_monitoring", status.EnableMonitor)
 
+	// loi khi lay kubeconfig (api chua co, khong du quyen...) khong lam hong refresh, giu kubeconfig cu trong state
+	kubeconfig, err := getKubernetesv2Kubeconfig(meta, d.Id())
+	if err != nil {
+		log.Printf("[WARN] error retrieving kubeconfig of Kubernetesv2 %s, keep the previous kubeconfig: %v", d.Id(), err)
+		if d.Get("host").(string) == "" {
+			_ = d.Set("host", kubernetes.MasterURL)
+		}
+	} else {
+		if kubeconfig.Host == "" {
+			kubeconfig.Host = kubernetes.MasterURL
+		}
+		setKubernetesv2Kubeconfig(d, kubeconfig)
+	}
+
 	// "zone":                        d.Get("zone").(string),
 	// 	"workerNumberEstimate":        d.Get("max_node_count").(int),
 
@@ -194,6 +225,24 @@ func updateMonitoringAddon(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 func resourceKubernetesv2Update(d *schema.ResourceData, meta interface{}) error {
+	// nang cap control plane truoc, cac node group duoc nang cap sau qua kubernetes_version cua node group
+	if d.HasChange("kubernetes_version") {
+		params := map[string]interface{}{
+			"version": d.Get("kubernetes_version").(string),
+		}
+		if strategy := getFirstBlock(d, "rollout_strategy"); strategy != nil {
+			params["rolloutStrategyType"] = "RollingUpdate"
+			params["rolloutStrategyMaxSurge"] = strconv.Itoa(strategy["max_surge"].(int))
+		}
+		if _, err := upgradeKubernetesv2(meta, d.Id(), params); err != nil {
+			return fmt.Errorf("error upgrade Kubernetesv2 [%s] to version %s: %v", d.Id(), d.Get("kubernetes_version").(string), err)
+		}
+		_, err := waitUntilKubernetesv2Upgraded(d, meta, d.Get("kubernetes_version").(string))
+		if err != nil {
+			return fmt.Errorf("error upgrade Kubernetesv2 [%s] to version %s: %v", d.Id(), d.Get("kubernetes_version").(string), err)
+		}
+	}
+
 	if d.HasChange("enable_autohealing") {
 		err := updateAutoHealingAddon(d, meta)
 		if err != nil {
@@ -213,7 +262,7 @@ func resourceKubernetesv2Update(d *schema.ResourceData, meta interface{}) error
 			return err
 		}
 	}
-	return resourceKubernetesv2Read(d, meta)
+	return readWithRetry(resourceKubernetesv2Read, d, meta)
 }
 
 func resourceKubernetesv2Delete(d *schema.ResourceData, meta interface{}) error {
@@ -231,7 +280,7 @@ func resourceKubernetesv2Delete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKubernetesv2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceKubernetesv2Read(d, meta)
+	err := readWithRetry(resourceKubernetesv2Read, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -258,3 +307,190 @@ func waitUntilKubernetesv2StatusChangedState(d *schema.ResourceData, meta interf
 		return obj.(gocmcapiv2.Kubernetesv2).State
 	})
 }
+
+// ngay sau khi goi /upgrade cluster van HEALTHY, nen chi coi la xong khi version da la version moi
+func waitUntilKubernetesv2Upgraded(d *schema.ResourceData, meta interface{}, version string) (interface{}, error) {
+	targetStatus := []string{"HEALTHY", "RUNNING", "active", "Ready", "Running"}
+	return waitUntilResourceStatusChanged(d, meta, targetStatus, []string{"ERROR", "SHUTDOWN", "FAILURE", "failure", "deleting"}, WaitConf{
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}, func(id string) (any, error) {
+		return getClient(meta).Kubernetesv2.Get(id)
+	}, func(obj interface{}) string {
+		cluster := obj.(gocmcapiv2.Kubernetesv2)
+		if arrayContains(targetStatus, cluster.State) && !isKubernetesVersionEqual(cluster.KubeletVersion, version) {
+			return "UPGRADING"
+		}
+		return cluster.State
+	})
+}
+
+// gocmcapiv2 chua ho tro nang cap version, goi truc tiep cloudops-core api
+func upgradeKubernetesv2(meta interface{}, id string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformAction("cloudops-core/api/v1/k8s/clusters/"+id+"/upgrade", params)
+}
+
+// compareKubernetesVersion so sanh 2 version dang v1.28.3 hoac 1.28.3, tra ve -1, 0, 1.
+// version co hau to (vd 1.28.3-cmc1) hoac khong phai so thi tra ve loi thay vi coi la 0
+func compareKubernetesVersion(a string, b string) (int, error) {
+	numsA, err := parseKubernetesVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	numsB, err := parseKubernetesVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < len(numsA) || i < len(numsB); i++ {
+		var numA, numB int
+		if i < len(numsA) {
+			numA = numsA[i]
+		}
+		if i < len(numsB) {
+			numB = numsB[i]
+		}
+		if numA != numB {
+			if numA < numB {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func parseKubernetesVersion(version string) ([]int, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	nums := make([]int, 0, len(parts))
+	for _, part := range parts {
+		num, err := strconv.Atoi(part)
+		if err != nil || num < 0 {
+			return nil, fmt.Errorf("invalid kubernetes version %q, expected format like 1.28.3", version)
+		}
+		nums = append(nums, num)
+	}
+	return nums, nil
+}
+
+// version khong parse duoc thi so sanh nguyen chuoi (bo tien to v)
+// isKubernetesVersionDowngrade chi chan khi ca 2 version deu parse duoc, version dang khac (vd 1.28.3-cmc1) de backend kiem tra
+func isKubernetesVersionDowngrade(oldVersion string, newVersion string) bool {
+	cmp, err := compareKubernetesVersion(newVersion, oldVersion)
+	return err == nil && cmp < 0
+}
+
+// suppressKubernetesVersionDiff bo qua khac biet tien to v giua cau hinh (1.28.3) va api (v1.28.3)
+func suppressKubernetesVersionDiff(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && new != "" && isKubernetesVersionEqual(old, new)
+}
+
+func isKubernetesVersionEqual(a string, b string) bool {
+	if cmp, err := compareKubernetesVersion(a, b); err == nil {
+		return cmp == 0
+	}
+	return strings.TrimPrefix(a, "v") == strings.TrimPrefix(b, "v")
+}
+
+type kubernetesv2Kubeconfig struct {
+	Raw                  string
+	Host                 string
+	ClusterCACertificate string
+	ClientCertificate    string
+	ClientKey            string
+	Token                string
+}
+
+func getKubernetesv2Kubeconfig(meta interface{}, id string) (kubernetesv2Kubeconfig, error) {
+	jsonStr, err := getClient(meta).Get("cloudops-core/api/v1/k8s/clusters/"+id+"/kubeconfig", map[string]string{})
+	if err != nil {
+		return kubernetesv2Kubeconfig{}, err
+	}
+	var obj struct {
+		Data string `json:"data"`
+	}
+	raw := jsonStr
+	if err := json.Unmarshal([]byte(jsonStr), &obj); err == nil {
+		raw = obj.Data
+	}
+	return parseKubeconfig(raw)
+}
+
+type kubeconfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+}
+
+// lay cluster & user theo current-context, neu khong co thi lay phan tu dau tien
+func parseKubeconfig(raw string) (kubernetesv2Kubeconfig, error) {
+	kubeconfig := kubernetesv2Kubeconfig{Raw: raw}
+	var file kubeconfigFile
+	if err := yaml.Unmarshal([]byte(raw), &file); err != nil {
+		return kubeconfig, fmt.Errorf("invalid kubeconfig: %v", err)
+	}
+
+	clusterName, userName := "", ""
+	for _, c := range file.Contexts {
+		if c.Name == file.CurrentContext {
+			clusterName, userName = c.Context.Cluster, c.Context.User
+			break
+		}
+	}
+	for i, c := range file.Clusters {
+		if c.Name == clusterName || (clusterName == "" && i == 0) {
+			kubeconfig.Host = c.Cluster.Server
+			kubeconfig.ClusterCACertificate = c.Cluster.CertificateAuthorityData
+			break
+		}
+	}
+	for i, u := range file.Users {
+		if u.Name == userName || (userName == "" && i == 0) {
+			kubeconfig.ClientCertificate = u.User.ClientCertificateData
+			kubeconfig.ClientKey = u.User.ClientKeyData
+			kubeconfig.Token = u.User.Token
+			break
+		}
+	}
+
+	for _, target := range []*string{&kubeconfig.ClusterCACertificate, &kubeconfig.ClientCertificate, &kubeconfig.ClientKey} {
+		if *target == "" {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(*target)
+		if err != nil {
+			return kubeconfig, fmt.Errorf("invalid base64 data in kubeconfig: %v", err)
+		}
+		*target = string(decoded)
+	}
+	return kubeconfig, nil
+}
+
+func setKubernetesv2Kubeconfig(d *schema.ResourceData, kubeconfig kubernetesv2Kubeconfig) {
+	_ = d.Set("host", kubeconfig.Host)
+	_ = d.Set("kubeconfig_raw", kubeconfig.Raw)
+	_ = d.Set("cluster_ca_certificate", kubeconfig.ClusterCACertificate)
+	_ = d.Set("client_certificate", kubeconfig.ClientCertificate)
+	_ = d.Set("client_key", kubeconfig.ClientKey)
+	_ = d.Set("token", kubeconfig.Token)
+}
@@ -1,7 +1,9 @@
 package cmccloudv2
 
 import (
+	"encoding/json"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 	"time"
@@ -52,6 +54,28 @@ func resourceKubernetesv2NodeGroup() *schema.Resource {
 					return fmt.Errorf("when `enable_autohealing` is 'false', `max_unhealthy_percent, node_startup_timeout_minutes must not be set")
 				}
 			}
+
+			if blocks := diff.Get("rollout_strategy").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+				strategy := blocks[0].(map[string]interface{})
+				if strategy["max_surge"].(int) == 0 && strategy["max_unavailable"].(int) == 0 {
+					return fmt.Errorf("`max_surge` and `max_unavailable` of `rollout_strategy` can't be both 0")
+				}
+			}
+			if diff.Id() != "" && diff.HasChange("kubernetes_version") && diff.Get("kubernetes_version").(string) != "" {
+				oldVersion, newVersion := diff.GetChange("kubernetes_version")
+				if isKubernetesVersionDowngrade(oldVersion.(string), newVersion.(string)) {
+					return fmt.Errorf("can't downgrade kubernetes_version from %s to %s", oldVersion.(string), newVersion.(string))
+				}
+			}
+
+			if blocks := diff.Get("kubelet_config").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+				kubelet := blocks[0].(map[string]interface{})
+				high := kubelet["image_gc_high_threshold_percent"].(int)
+				low := kubelet["image_gc_low_threshold_percent"].(int)
+				if high > 0 && low > 0 && low >= high {
+					return fmt.Errorf("`image_gc_low_threshold_percent` must < `image_gc_high_threshold_percent`")
+				}
+			}
 			return nil
 		},
 	}
@@ -111,6 +135,12 @@ func resourceKubernetesv2NodeGroupCreate(d *schema.ResourceData, meta interface{
 	params["workerImageGPUTag"] = d.Get("image_gpu_tag").(string)
 	params["volumeType"] = d.Get("volume_type").(string)
 	params["volumeSize"] = d.Get("volume_size").(int)
+	for k, v := range buildKubernetesv2NodeGroupNodeConfig(d) {
+		params[k] = v
+	}
+	if v, ok := d.GetOk("kubernetes_version"); ok {
+		params["version"] = v.(string)
+	}
 
 	if d.Get("enable_autoscale").(bool) {
 		// kiem tra xem cluster co enable auto scale ko, neu ko enable => ko support
@@ -152,14 +182,18 @@ func resourceKubernetesv2NodeGroupCreate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	return resourceKubernetesv2NodeGroupRead(d, meta)
+	return readWithRetry(resourceKubernetesv2NodeGroupRead, d, meta)
 }
 
 func resourceKubernetesv2NodeGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*CombinedConfig).goCMCClient()
-	nodegroup, err := client.Kubernetesv2.GetNodeGroup(d.Get("cluster_id").(string), d.Id())
+	return readKubernetesv2NodeGroup(d, meta, false)
+}
+
+// importing = true khi import, luc do set ca kubelet_config lay tu api vi state chua co
+func readKubernetesv2NodeGroup(d *schema.ResourceData, meta interface{}, importing bool) error {
+	nodegroup, config, err := getKubernetesv2NodeGroupWithNodeConfig(meta, d.Get("cluster_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Kubernetesv2 NodeGroup %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Kubernetesv2 NodeGroup")
 	}
 
 	_ = d.Set("id", nodegroup.ID)
@@ -173,6 +207,22 @@ func resourceKubernetesv2NodeGroupRead(d *schema.ResourceData, meta interface{})
 	_ = d.Set("enable_autoscale", false)
 	_ = d.Set("enable_autohealing", false)
 	_ = d.Set("status", nodegroup.Status)
+
+	// api co the khong tra ve version (vd node group dang tao), giu gia tri cu de khong bi diff
+	if config.Version != "" {
+		_ = d.Set("kubernetes_version", config.Version)
+	}
+	_ = d.Set("labels", config.Labels)
+	taints := make([]map[string]interface{}, 0, len(config.Taints))
+	for _, taint := range config.Taints {
+		taints = append(taints, map[string]interface{}{
+			"key":    taint.Key,
+			"value":  taint.Value,
+			"effect": taint.Effect,
+		})
+	}
+	_ = d.Set("taints", taints)
+	setKubernetesv2NodeGroupKubeletConfig(d, config, importing)
 	for _, provider := range nodegroup.ExternalProviders {
 		if strings.Contains(provider.Name, "auto-scale") {
 			if provider.Status == "active" && provider.Config.MaxNode > provider.Config.MinNode {
@@ -252,8 +302,157 @@ func resourceKubernetesv2NodeGroupUpdate(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("error creating Kubernetes NodeGroup: %v", err)
 		}
 	}
+	if d.HasChange("kubernetes_version") && d.Get("kubernetes_version").(string) != "" {
+		err := upgradeKubernetesv2NodeGroupAndWait(d, meta)
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChanges("labels", "taints", "kubelet_config") {
+		if _, err := updateKubernetesv2NodeGroupNodeConfig(meta, clusterId, d.Id(), buildKubernetesv2NodeGroupNodeConfig(d)); err != nil {
+			return fmt.Errorf("error updating node config of Kubernetesv2 NodeGroup: %v", err)
+		}
+		_, err := waitUntilKubernetesv2NodeGroupStatusChangedState(d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error updating node config of Kubernetesv2 NodeGroup: %v", err)
+		}
+	}
+
+	return readWithRetry(resourceKubernetesv2NodeGroupRead, d, meta)
+}
 
-	return resourceKubernetesv2NodeGroupRead(d, meta)
+func upgradeKubernetesv2NodeGroupAndWait(d *schema.ResourceData, meta interface{}) error {
+	clusterId := d.Get("cluster_id").(string)
+	version := d.Get("kubernetes_version").(string)
+	// version cua node khong duoc cao hon control plane
+	cluster, err := getClient(meta).Kubernetesv2.Get(clusterId)
+	if err != nil {
+		return fmt.Errorf("error getting Kubernetesv2 Cluster: %v", err)
+	}
+	if cmp, err := compareKubernetesVersion(version, cluster.KubeletVersion); err == nil && cmp > 0 {
+		return fmt.Errorf("kubernetes_version %s of node group must not be greater than version %s of the cluster, upgrade the cluster first", version, cluster.KubeletVersion)
+	}
+
+	params := map[string]interface{}{
+		"version": version,
+	}
+	if strategy := getFirstBlock(d, "rollout_strategy"); strategy != nil {
+		params["rolloutStrategyType"] = "RollingUpdate"
+		params["rolloutStrategyMaxSurge"] = strconv.Itoa(strategy["max_surge"].(int))
+		params["rolloutStrategyMaxUnavailable"] = strconv.Itoa(strategy["max_unavailable"].(int))
+	}
+	if _, err := upgradeKubernetesv2NodeGroup(meta, clusterId, d.Id(), params); err != nil {
+		return fmt.Errorf("error upgrade Kubernetesv2 NodeGroup [%s] to version %s: %v", d.Id(), version, err)
+	}
+	_, err = waitUntilKubernetesv2NodeGroupUpgraded(d, meta, version)
+	if err != nil {
+		return fmt.Errorf("error upgrade Kubernetesv2 NodeGroup [%s] to version %s: %v", d.Id(), version, err)
+	}
+	return nil
+}
+
+// ngay sau khi goi /upgrade node group van HEALTHY, nen chi coi la xong khi version cua node group da la version moi
+func waitUntilKubernetesv2NodeGroupUpgraded(d *schema.ResourceData, meta interface{}, version string) (interface{}, error) {
+	type nodeGroupWithConfig struct {
+		nodegroup gocmcapiv2.Kubernetesv2NodeGroup
+		config    kubernetesv2NodeGroupNodeConfig
+	}
+	targetStatus := []string{"HEALTHY", "RUNNING", "active", "Ready", "Running"}
+	return waitUntilResourceStatusChanged(d, meta, targetStatus, []string{"ERROR", "SHUTDOWN", "FAILURE", "failure", "deleting"}, WaitConf{
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}, func(id string) (any, error) {
+		nodegroup, config, err := getKubernetesv2NodeGroupWithNodeConfig(meta, d.Get("cluster_id").(string), id)
+		return nodeGroupWithConfig{nodegroup, config}, err
+	}, func(obj interface{}) string {
+		res := obj.(nodeGroupWithConfig)
+		if arrayContains(targetStatus, res.nodegroup.Status) && !isKubernetesVersionEqual(res.config.Version, version) {
+			return "UPGRADING"
+		}
+		return res.nodegroup.Status
+	})
+}
+
+// buildKubernetesv2NodeGroupNodeConfig tra ve labels, taints, kubelet config dung khi tao/cap nhat node group
+func buildKubernetesv2NodeGroupNodeConfig(d *schema.ResourceData) map[string]interface{} {
+	taints := make([]map[string]interface{}, 0)
+	for _, v := range d.Get("taints").(*schema.Set).List() {
+		taint := v.(map[string]interface{})
+		taints = append(taints, map[string]interface{}{
+			"key":    taint["key"].(string),
+			"value":  taint["value"].(string),
+			"effect": taint["effect"].(string),
+		})
+	}
+	params := map[string]interface{}{
+		"labels": d.Get("labels").(map[string]interface{}),
+		"taints": taints,
+	}
+	// luon gui kubeletConfig nhung chi gom cac truong dang duoc khai bao, truong khong khai bao thi bo qua
+	// de backend dung gia tri mac dinh cua kubelet
+	kubelet := getFirstBlock(d, "kubelet_config")
+	kubeletConfig := map[string]interface{}{}
+	for key, apiKey := range map[string]string{
+		"max_pods":                        "maxPods",
+		"cpu_manager_policy":              "cpuManagerPolicy",
+		"image_gc_high_threshold_percent": "imageGCHighThresholdPercent",
+		"image_gc_low_threshold_percent":  "imageGCLowThresholdPercent",
+	} {
+		if v := nullIfZero(kubelet[key]); v != nil {
+			kubeletConfig[apiKey] = v
+		}
+	}
+	params["kubeletConfig"] = kubeletConfig
+	return params
+}
+
+func nullIfZero(v interface{}) interface{} {
+	switch val := v.(type) {
+	case int:
+		if val == 0 {
+			return nil
+		}
+	case string:
+		if val == "" {
+			return nil
+		}
+	case nil:
+		return nil
+	}
+	return v
+}
+
+// chi set cac truong kubelet_config dang duoc khai bao, cac truong con lai de trong
+// tranh drift do gia tri mac dinh cua kubelet; khi import thi lay het gia tri tu api
+func setKubernetesv2NodeGroupKubeletConfig(d *schema.ResourceData, config kubernetesv2NodeGroupNodeConfig, importing bool) {
+	current := getFirstBlock(d, "kubelet_config")
+	if current == nil && !importing {
+		return
+	}
+	if current == nil {
+		current = map[string]interface{}{}
+	}
+	kubelet := config.KubeletConfig
+	values := map[string]interface{}{
+		"max_pods":                        kubelet.MaxPods,
+		"cpu_manager_policy":              kubelet.CPUManagerPolicy,
+		"image_gc_high_threshold_percent": kubelet.ImageGCHighThresholdPercent,
+		"image_gc_low_threshold_percent":  kubelet.ImageGCLowThresholdPercent,
+	}
+	hasValue := false
+	for key := range values {
+		if !importing && nullIfZero(current[key]) == nil {
+			values[key] = current[key]
+		}
+		if nullIfZero(values[key]) != nil {
+			hasValue = true
+		}
+	}
+	if importing && !hasValue {
+		return
+	}
+	_ = d.Set("kubelet_config", []map[string]interface{}{values})
 }
 
 func resourceKubernetesv2NodeGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -271,25 +470,9 @@ func resourceKubernetesv2NodeGroupDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceKubernetesv2NodeGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	id := d.Id()
-
-	// Tách ID thành hai tham số
-	parts := strings.Split(id, "/")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("expected import ID to be in the format 'cluster_id/nodegroup_id'")
-	}
-
-	clusterId := parts[0]
-	nodegroupId := parts[1]
-
-	if err := d.Set("cluster_id", clusterId); err != nil {
-		return nil, fmt.Errorf("error setting cluster_id: %v", err)
-	}
-
-	// Thiết lập ID cho resource
-	d.SetId(nodegroupId)
-	err := resourceKubernetesv2NodeGroupRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "cluster_id", true, func(d *schema.ResourceData, meta interface{}) error {
+		return readKubernetesv2NodeGroup(d, meta, true)
+	})
 }
 
 func waitUntilKubernetesv2NodeGroupDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -312,3 +495,48 @@ func waitUntilKubernetesv2NodeGroupStatusChangedState(d *schema.ResourceData, me
 		return obj.(gocmcapiv2.Kubernetesv2NodeGroup).Status
 	})
 }
+
+type kubernetesv2NodeGroupNodeConfig struct {
+	Version string            `json:"version"`
+	Labels  map[string]string `json:"labels"`
+	Taints  []struct {
+		Key    string `json:"key"`
+		Value  string `json:"value"`
+		Effect string `json:"effect"`
+	} `json:"taints"`
+	KubeletConfig struct {
+		MaxPods                     int    `json:"maxPods"`
+		CPUManagerPolicy            string `json:"cpuManagerPolicy"`
+		ImageGCHighThresholdPercent int    `json:"imageGCHighThresholdPercent"`
+		ImageGCLowThresholdPercent  int    `json:"imageGCLowThresholdPercent"`
+	} `json:"kubeletConfig"`
+}
+
+// gocmcapiv2 chua ho tro labels, taints, kubelet config cua node group, goi truc tiep cloudops-core api
+// va parse ca node group lan node config tu cung 1 response
+func getKubernetesv2NodeGroupWithNodeConfig(meta interface{}, clusterId string, id string) (gocmcapiv2.Kubernetesv2NodeGroup, kubernetesv2NodeGroupNodeConfig, error) {
+	var nodegroup struct {
+		Data gocmcapiv2.Kubernetesv2NodeGroup `json:"data"`
+	}
+	var config struct {
+		Data kubernetesv2NodeGroupNodeConfig `json:"data"`
+	}
+	jsonStr, err := getClient(meta).Get("cloudops-core/api/v1/k8s/clusters/"+clusterId+"/node-groups/"+id, map[string]string{})
+	if err != nil {
+		return nodegroup.Data, config.Data, err
+	}
+	if err = json.Unmarshal([]byte(jsonStr), &nodegroup); err != nil {
+		return nodegroup.Data, config.Data, err
+	}
+	// node config khong dung format thi bo qua, khong lam hong ca lan read
+	if err := json.Unmarshal([]byte(jsonStr), &config); err != nil {
+		log.Printf("[WARN] unable to parse node config of Kubernetesv2 NodeGroup %s: %v", id, err)
+	}
+	return nodegroup.Data, config.Data, nil
+}
+func updateKubernetesv2NodeGroupNodeConfig(meta interface{}, clusterId string, id string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformUpdate("cloudops-core/api/v1/k8s/clusters/"+clusterId+"/node-groups/"+id, params)
+}
+func upgradeKubernetesv2NodeGroup(meta interface{}, clusterId string, id string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformAction("cloudops-core/api/v1/k8s/clusters/"+clusterId+"/node-groups/"+id+"/upgrade", params)
+}
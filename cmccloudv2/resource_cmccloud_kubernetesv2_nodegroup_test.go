@@ -0,0 +1,60 @@
+package cmccloudv2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildKubernetesv2NodeGroupNodeConfig(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  map[string]interface{}
+		want map[string]interface{}
+	}{
+		{
+			name: "no kubelet_config",
+			raw:  map[string]interface{}{},
+			want: map[string]interface{}{},
+		},
+		{
+			// chi gui cac truong duoc khai bao, khong gui null
+			name: "partial kubelet_config",
+			raw: map[string]interface{}{
+				"kubelet_config": []interface{}{map[string]interface{}{
+					"max_pods":           110,
+					"cpu_manager_policy": "static",
+				}},
+			},
+			want: map[string]interface{}{
+				"maxPods":          110,
+				"cpuManagerPolicy": "static",
+			},
+		},
+		{
+			name: "full kubelet_config",
+			raw: map[string]interface{}{
+				"kubelet_config": []interface{}{map[string]interface{}{
+					"max_pods":                        50,
+					"cpu_manager_policy":              "none",
+					"image_gc_high_threshold_percent": 85,
+					"image_gc_low_threshold_percent":  80,
+				}},
+			},
+			want: map[string]interface{}{
+				"maxPods":                     50,
+				"cpuManagerPolicy":            "none",
+				"imageGCHighThresholdPercent": 85,
+				"imageGCLowThresholdPercent":  80,
+			},
+		},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, kubernetesv2NodeGroupSchema(), c.raw)
+		params := buildKubernetesv2NodeGroupNodeConfig(d)
+		if got := params["kubeletConfig"]; !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: kubeletConfig = %#v, want %#v", c.name, got, c.want)
+		}
+	}
+}
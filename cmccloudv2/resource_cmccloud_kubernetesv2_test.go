@@ -0,0 +1,43 @@
+package cmccloudv2
+
+import "testing"
+
+func TestIsKubernetesVersionDowngrade(t *testing.T) {
+	cases := []struct {
+		old, new string
+		want     bool
+	}{
+		{"1.28.3", "1.29.0", false},
+		{"v1.28.3", "1.28.3", false},
+		{"1.28.3", "1.28.2", true},
+		{"v1.29.0", "1.28.10", true},
+		// version co hau to cua nha cung cap khong parse duoc, de backend kiem tra
+		{"1.28.3-cmc1", "1.28.2", false},
+		{"1.28.3", "1.27.9-cmc2", false},
+		{"", "1.28.3", false},
+	}
+	for _, c := range cases {
+		if got := isKubernetesVersionDowngrade(c.old, c.new); got != c.want {
+			t.Errorf("isKubernetesVersionDowngrade(%q, %q) = %v, want %v", c.old, c.new, got, c.want)
+		}
+	}
+}
+
+func TestSuppressKubernetesVersionDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		want     bool
+	}{
+		{"v1.28.3", "1.28.3", true},
+		{"1.28.3", "v1.28.3", true},
+		{"v1.28.3-cmc1", "1.28.3-cmc1", true},
+		{"v1.28.3", "1.29.0", false},
+		{"", "1.28.3", false},
+		{"v1.28.3", "", false},
+	}
+	for _, c := range cases {
+		if got := suppressKubernetesVersionDiff("kubernetes_version", c.old, c.new, nil); got != c.want {
+			t.Errorf("suppressKubernetesVersionDiff(%q, %q) = %v, want %v", c.old, c.new, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package cmccloudv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceMongodbInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongodbInstanceCreate,
+		Read:   resourceMongodbInstanceRead,
+		Update: resourceMongodbInstanceUpdate,
+		Delete: resourceMongodbInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongodbInstanceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        mongodbinstanceSchema(),
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			sourceType := diff.Get("source_type").(string)
+			_, sourceIDSet := diff.GetOk("source_id")
+			_, backupIDSet := diff.GetOk("backup_id")
+			if sourceType == "new" {
+				if sourceIDSet || backupIDSet {
+					return fmt.Errorf("when source_type is 'new', 'source_id' and 'backup_id' must not be set")
+				}
+			} else if sourceType == "backup" {
+				if !backupIDSet {
+					return fmt.Errorf("when source_type is 'backup', 'backup_id' must be set")
+				}
+			} else if sourceType == "instance" {
+				if !sourceIDSet {
+					return fmt.Errorf("when source_type is 'instance', 'source_id' must be set")
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func resourceMongodbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	subnet, err := client.Subnet.Get(d.Get("subnet_id").(string))
+	if err != nil {
+		return fmt.Errorf("subnet id is not valid %v", err)
+	}
+
+	datastores, err := listMongodbDatastores(meta)
+	if err != nil {
+		return fmt.Errorf("can't get list of datastore %v", err)
+	}
+
+	databaseEngine := d.Get("database_engine").(string)
+	databaseVersion := d.Get("database_version").(string)
+	databaseMode := d.Get("database_mode").(string)
+
+	mongodbMode := ""
+	datastoreId := ""
+	datastoreCode := ""
+	datastoreVersionId := ""
+	datastoreModeId := ""
+	for _, datastore := range datastores {
+		if strings.EqualFold(databaseEngine, datastore.Name) {
+			gocmcapiv2.Logs("found datastore " + databaseEngine + " & " + datastore.Name)
+			datastoreCode = datastore.Code
+			datastoreId = datastore.ID
+			for _, version := range datastore.VersionInfos {
+				if strings.EqualFold(databaseVersion, version.VersionName) {
+					datastoreVersionId = version.ID
+					for _, mode := range version.ModeInfo {
+						if caseInsensitiveContains(mode.Name, databaseMode) {
+							datastoreModeId = mode.ID
+							mongodbMode = mode.Code
+						}
+					}
+				}
+			}
+			if datastoreVersionId == "" {
+				return fmt.Errorf("not found database_version %s", databaseVersion)
+			}
+
+			if datastoreModeId == "" {
+				return fmt.Errorf("not found database_mode %s", databaseMode)
+			}
+		}
+	}
+
+	if datastoreCode == "" {
+		return fmt.Errorf("not found database_engine %s", databaseEngine)
+	}
+
+	_, slaveCountSet := d.GetOk("slave_count")
+	if mongodbMode == "standalone" {
+		if slaveCountSet {
+			return fmt.Errorf("when `database_mode` is standalone, 'slave_count' must not be set")
+		}
+		if d.Get("zone_secondaries").(string) != "" {
+			return fmt.Errorf("when `database_mode` is standalone, 'zone_secondaries' must not be set")
+		}
+	} else if !slaveCountSet {
+		return fmt.Errorf("when `database_mode` is not standalone, 'slave_count' must be set")
+	}
+
+	err = checkSecurityGroupConflict(d, meta)
+	if err != nil {
+		return err
+	}
+
+	params := map[string]interface{}{
+		"billing_mode": d.Get("billing_mode").(string),
+
+		"name":                 d.Get("name").(string),
+		"securityGroupIds":     strings.Join(getStringArrayFromTypeSet(d.Get("security_group_ids").(*schema.Set)), ","),
+		"flavorId":             d.Get("flavor_id").(string),
+		"password":             d.Get("password").(string),
+		"backupId":             d.Get("backup_id").(string),
+		"sourceType":           d.Get("source_type").(string),
+		"sourceId":             d.Get("source_id").(string),
+		"volumeSize":           d.Get("volume_size").(int),
+		"volumeType":           d.Get("volume_type").(string),
+		"groupConfigurationId": d.Get("mongodb_configuration_id").(string),
+		"networkId":            subnet.NetworkID,
+		"subnetId":             subnet.ID,
+		"datastore": map[string]string{
+			"datastoreCode":      datastoreCode,
+			"datastoreVersionId": datastoreVersionId,
+			"datastoreModeId":    datastoreModeId,
+		},
+		"datastore_type": datastoreId,
+	}
+
+	requestMetadata := map[string]interface{}{
+		"password": d.Get("password").(string),
+	}
+	zonePrimary := d.Get("zone_primary").(string)
+	if mongodbMode == "standalone" {
+		requestMetadata["zone"] = zonePrimary
+	} else {
+		zones := []string{zonePrimary}
+		for _, zone := range strings.Split(d.Get("zone_secondaries").(string), ",") {
+			if zone = strings.TrimSpace(zone); zone != "" {
+				zones = append(zones, zone)
+			}
+		}
+		requestMetadata["zone"] = zonePrimary
+		requestMetadata["zones"] = zones
+		requestMetadata["numOfSlaves"] = d.Get("slave_count").(int)
+		params["zones"] = zones
+	}
+
+	jsonData, err := json.Marshal(requestMetadata)
+	if err != nil {
+		return fmt.Errorf("error creating MongoDB Instance: %s", err)
+	}
+	params["requestMetadata"] = string(jsonData)
+
+	instance, err := client.RedisInstance.Create(params)
+	if err != nil {
+		return fmt.Errorf("error creating MongoDB Instance: %s", err)
+	}
+	d.SetId(instance.Data.InstanceID)
+	_, err = waitUntilMongodbInstanceJobFinished(d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating MongoDB Instance: %s", err)
+	}
+	return readWithRetry(resourceMongodbInstanceRead, d, meta)
+}
+
+func resourceMongodbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	// mongodb & redis instances are served by the same dbaas api
+	instance, err := client.RedisInstance.Get(d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving MongoDB Instance")
+	}
+
+	billingMode, _ := client.BillingMode.GetBilingMode(d.Id(), "RDS")
+	if billingMode != "" {
+		_ = d.Set("billing_mode", billingMode)
+	}
+	_ = d.Set("name", instance.Name)
+	_ = d.Set("database_engine", instance.DatastoreName)
+	_ = d.Set("database_version", instance.DatastoreVersion)
+	_ = d.Set("database_mode", instance.DatastoreMode)
+
+	var securityGroupIds []string
+	err = json.Unmarshal([]byte(instance.SecurityClientIds), &securityGroupIds)
+	if err != nil {
+		return fmt.Errorf("error when get info of MongoDB Instance [%s]: %v", d.Id(), err)
+	}
+
+	_ = d.Set("security_group_ids", securityGroupIds)
+	_ = d.Set("flavor_id", instance.FlavorID)
+	_ = d.Set("volume_size", instance.VolumeSize)
+	_ = d.Set("subnet_id", instance.SubnetID)
+
+	if instance.DataDetail.MasterInfo.ZoneName != "" {
+		_ = d.Set("zone_primary", instance.DataDetail.MasterInfo.ZoneName)
+	}
+	if len(instance.DataDetail.SlavesInfo) > 0 {
+		_ = d.Set("slave_count", len(instance.DataDetail.SlavesInfo))
+	}
+	if d.Get("mongodb_configuration_id").(string) != "" {
+		_ = d.Set("mongodb_configuration_id", instance.GroupConfigID)
+	}
+	_ = d.Set("status", instance.Status)
+	_ = d.Set("created_at", instance.Created)
+	return nil
+}
+
+func resourceMongodbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	id := d.Id()
+	if d.HasChange("billing_mode") {
+		_, err := client.BillingMode.SetRedisInstanceBilingMode(id, d.Get("billing_mode").(string))
+		if err != nil {
+			return fmt.Errorf("error when billing mode of MongoDB Instance [%s]: %v", id, err)
+		}
+	}
+	if d.HasChange("password") {
+		_, err := client.RedisInstance.SetPassword(id, d.Get("password").(string))
+		if err != nil {
+			return fmt.Errorf("error when update password of MongoDB Instance [%s]: %v", id, err)
+		}
+		_, err = waitUntilMongodbInstanceJobFinished(d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error when update password of MongoDB Instance [%s]: %v", id, err)
+		}
+	}
+	if d.HasChange("mongodb_configuration_id") {
+		configurationId := d.Get("mongodb_configuration_id").(string)
+		if configurationId == "" {
+			// bo mongodb_configuration_id => quay ve configuration mac dinh, giong redis
+			defaultTemplateId, err := getMongodbDefaultConfigurationId(meta, d)
+			if err != nil {
+				return err
+			}
+			configurationId = defaultTemplateId
+		}
+		_, err := client.RedisInstance.SetConfigurationGroupId(id, configurationId)
+		if err != nil {
+			return fmt.Errorf("error when set configuration group to %s of MongoDB Instance %s: %v", configurationId, id, err)
+		}
+		_, err = waitUntilMongodbInstanceJobFinished(d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error when set configuration group to %s of MongoDB Instance %s: %v", configurationId, id, err)
+		}
+	}
+	if d.HasChange("security_group_ids") {
+		err := checkSecurityGroupConflict(d, meta)
+		if err != nil {
+			return err
+		}
+
+		removes, adds := getDiffSet(d.GetChange("security_group_ids"))
+
+		for _, securityGroupId := range removes.List() {
+			_, err := client.RedisInstance.DetachSecurityGroupId(id, securityGroupId.(string))
+			if err != nil {
+				return fmt.Errorf("error detach security group %s from %s: %v", securityGroupId, id, err)
+			}
+			if _, err = waitUntilRedisInstanceDetachFinished(d, meta, securityGroupId.(string)); err != nil {
+				return fmt.Errorf("error detach security group %s from %s: %v", securityGroupId, id, err)
+			}
+		}
+		for _, securityGroupId := range adds.List() {
+			_, err := client.RedisInstance.AttachSecurityGroupId(id, securityGroupId.(string))
+			if err != nil {
+				return fmt.Errorf("error attach security group %s from %s: %v", securityGroupId, id, err)
+			}
+			if _, err = waitUntilRedisInstanceAttachFinished(d, meta, securityGroupId.(string)); err != nil {
+				return fmt.Errorf("error attach security group %s from %s: %v", securityGroupId, id, err)
+			}
+		}
+	}
+
+	return readWithRetry(resourceMongodbInstanceRead, d, meta)
+}
+
+func resourceMongodbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	_, err := client.RedisInstance.Delete(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error delete mongodb instance: %v", err)
+	}
+	_, err = waitUntilRedisInstanceDeleted(d, meta)
+	if err != nil {
+		return fmt.Errorf("error delete mongodb instance: %v", err)
+	}
+	return nil
+}
+
+func resourceMongodbInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	err := readWithRetry(resourceMongodbInstanceRead, d, meta)
+	return []*schema.ResourceData{d}, err
+}
+
+// RedisInstance.ListDatastore is pinned to datastoreCode=redis, query the same endpoint for mongodb
+func getMongodbDefaultConfigurationId(meta interface{}, d *schema.ResourceData) (string, error) {
+	defaultTemplates, err := getClient(meta).RedisConfiguration.List(map[string]string{
+		"page":          "1",
+		"size":          "1000",
+		"datastoreCode": "mongodb",
+		"getDefault":    "true",
+	})
+	if err != nil {
+		return "", fmt.Errorf("error when getting default mongodb configuration templates: %v", err)
+	}
+	databaseEngine := d.Get("database_engine").(string)
+	databaseVersion := d.Get("database_version").(string)
+	databaseMode := d.Get("database_mode").(string)
+	for _, template := range defaultTemplates {
+		if strings.EqualFold(template.DatastoreName, databaseEngine) && template.DatastoreVersion == databaseVersion && strings.EqualFold(template.DatastoreMode, databaseMode) {
+			if template.ID2 != "" {
+				return template.ID2, nil
+			}
+			return template.ID, nil
+		}
+	}
+	return "", fmt.Errorf("not found default mongodb configuration template for %s %s %s", databaseEngine, databaseVersion, databaseMode)
+}
+
+func listMongodbDatastores(meta interface{}) ([]gocmcapiv2.RedisDatastore, error) {
+	jsonStr, err := getClient(meta).Get("cloudops-core/api/v1/dbaas/datastore", map[string]string{"datastoreCode": "mongodb"})
+	if err != nil {
+		return []gocmcapiv2.RedisDatastore{}, err
+	}
+	var obj gocmcapiv2.RedisDatastoreListWrapper
+	err = json.Unmarshal([]byte(jsonStr), &obj)
+	if err != nil {
+		return []gocmcapiv2.RedisDatastore{}, err
+	}
+	return obj.Data.Docs, nil
+}
+
+func waitUntilMongodbInstanceJobFinished(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+	return waitUntilResourceStatusChanged(d, meta, []string{"HEALTHY", "RUNNING", "SHUTDOWN"}, []string{"ERROR"}, WaitConf{
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 20 * time.Second,
+	}, func(id string) (any, error) {
+		return getClient(meta).RedisInstance.Get(id)
+	}, func(obj interface{}) string {
+		return strings.ToUpper(obj.(gocmcapiv2.RedisInstance).Status)
+	})
+}
@@ -79,14 +79,14 @@ func resourceRedisConfigurationCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error creating Redis Configuration: %s", err)
 	}
 	d.SetId(configuration.ID)
-	return resourceRedisConfigurationRead(d, meta)
+	return readWithRetry(resourceRedisConfigurationRead, d, meta)
 }
 
 func resourceRedisConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	configuration, err := client.RedisConfiguration.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Redis Configuration %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Redis Configuration")
 	}
 
 	_ = d.Set("id", configuration.ID)
@@ -127,7 +127,7 @@ func resourceRedisConfigurationUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("error when update parameters of Redis Configuration [%s]: %v", id, err)
 		}
 	}
-	return resourceRedisConfigurationRead(d, meta)
+	return readWithRetry(resourceRedisConfigurationRead, d, meta)
 }
 
 // func convertRedisParametersJsonString(params map[string]interface{}) string {
@@ -170,7 +170,7 @@ func resourceRedisConfigurationDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceRedisConfigurationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceRedisConfigurationRead(d, meta)
+	err := readWithRetry(resourceRedisConfigurationRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -183,7 +183,7 @@ func resourceRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("error creating RedisDatabase Instance: %s", err)
 	}
-	return resourceRedisInstanceRead(d, meta)
+	return readWithRetry(resourceRedisInstanceRead, d, meta)
 }
 
 func checkSecurityGroupConflict(d *schema.ResourceData, meta interface{}) error {
@@ -210,7 +210,7 @@ func resourceRedisInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	instance, err := client.RedisInstance.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving RedisDatabase Instance %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving RedisDatabase Instance")
 	}
 
 	billingMode, err := client.BillingMode.GetBilingMode(d.Id(), "RDS")
@@ -338,7 +338,7 @@ func resourceRedisInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceRedisInstanceRead(d, meta)
+	return readWithRetry(resourceRedisInstanceRead, d, meta)
 }
 
 func resourceRedisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -356,7 +356,7 @@ func resourceRedisInstanceDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRedisInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceRedisInstanceRead(d, meta)
+	err := readWithRetry(resourceRedisInstanceRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -34,6 +34,10 @@ func resourceSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error
 	if err := checkRuleErrors(d, "rule"); err != nil {
 		return fmt.Errorf("invalid rule: %s", err)
 	}
+	manageRules := d.Get("manage_rules").(bool)
+	if !manageRules && d.Get("rule").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("`rule` can not be set when `manage_rules` is false")
+	}
 	// If all rules are valid, proceed with creating the security group.
 	group, err := client.SecurityGroup.Create(map[string]interface{}{
 		"name":          d.Get("name").(string),
@@ -46,6 +50,9 @@ func resourceSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error creating Security Group: %s", err)
 	}
 	d.SetId(group.ID)
+	if !manageRules {
+		return readWithRetry(resourceSecurityGroupRead, d, meta)
+	}
 
 	// get security group and delete all default rules
 	sg, err := client.SecurityGroup.Get(d.Id())
@@ -77,7 +84,7 @@ func resourceSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceSecurityGroupRead(d, meta)
+	return readWithRetry(resourceSecurityGroupRead, d, meta)
 }
 
 func resourceSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
@@ -85,12 +92,17 @@ func resourceSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 	sg, err := client.SecurityGroup.Get(id)
 	if err != nil {
-		return fmt.Errorf("error receiving Security Group %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error receiving Security Group")
 	}
 	_ = d.Set("name", sg.Name)
 	_ = d.Set("description", sg.Description)
 	_ = d.Set("stateful", sg.Stateful)
-	_ = d.Set("rule", convertSecurityGroupRules(sg.Rules))
+	if d.Get("manage_rules").(bool) {
+		_ = d.Set("rule", convertSecurityGroupRules(sg.Rules))
+	} else {
+		// rule duoc quan ly boi cmccloudv2_security_group_rule, khong luu vao state cua group
+		_ = d.Set("rule", nil)
+	}
 	return nil
 }
 
@@ -110,7 +122,7 @@ func resourceSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("rule") {
+	if d.HasChange("rule") && d.Get("manage_rules").(bool) {
 		rulesToRemove, rulesToAdd := getDiffSet(d.GetChange("rule"))
 
 		log.Printf("[DEBUG] openstack_compute_secgroup_v2 %s rules to add: %v", d.Id(), rulesToAdd)
@@ -145,7 +157,7 @@ func resourceSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 	}
-	return resourceSecurityGroupRead(d, meta)
+	return readWithRetry(resourceSecurityGroupRead, d, meta)
 }
 
 func resourceSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -162,7 +174,8 @@ func resourceSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSecurityGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceSecurityGroupRead(d, meta)
+	_ = d.Set("manage_rules", true)
+	err := readWithRetry(resourceSecurityGroupRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -0,0 +1,108 @@
+package cmccloudv2
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cmc-cloud/gocmcapiv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSecurityGroupRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityGroupRuleCreate,
+		Read:   resourceSecurityGroupRuleRead,
+		Delete: resourceSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityGroupRuleImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema:        securityGroupStandaloneRuleSchema(),
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.Get("cidr").(string) != "" && diff.Get("dest_securitygroup_id").(string) != "" {
+				return fmt.Errorf("only one of cidr or dest_securitygroup_id can be set")
+			}
+			portRangeMin := diff.Get("port_range_min").(int)
+			portRangeMax := diff.Get("port_range_max").(int)
+			if portRangeMin != 0 && portRangeMax != 0 && portRangeMin > portRangeMax {
+				return fmt.Errorf("port_range_max must be >= port_range_min")
+			}
+			return nil
+		},
+	}
+}
+
+func resourceSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	rule, err := client.SecurityGroup.CreateRule(d.Get("security_group_id").(string), map[string]interface{}{
+		"ether_type":            d.Get("ether_type").(string),
+		"direction":             d.Get("direction").(string),
+		"protocol":              d.Get("protocol").(string),
+		"port_range_min":        d.Get("port_range_min").(int),
+		"port_range_max":        d.Get("port_range_max").(int),
+		"cidr":                  d.Get("cidr").(string),
+		"dest_securitygroup_id": d.Get("dest_securitygroup_id").(string),
+		"description":           d.Get("description").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Security Group Rule: %s", err)
+	}
+	d.SetId(rule.ID)
+	return readWithRetry(resourceSecurityGroupRuleRead, d, meta)
+}
+
+func resourceSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+	rule, err := getSecurityGroupRule(meta, d.Get("security_group_id").(string), d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving Security Group Rule")
+	}
+	_ = d.Set("ether_type", rule.EtherType)
+	_ = d.Set("direction", rule.Direction)
+	_ = d.Set("protocol", rule.Protocol)
+	_ = d.Set("port_range_min", rule.PortRangeMin)
+	_ = d.Set("port_range_max", rule.PortRangeMax)
+	_ = d.Set("cidr", anyToString(rule.CIDR))
+	_ = d.Set("dest_securitygroup_id", anyToString(rule.DestSecuritygroupID))
+	_ = d.Set("description", rule.Description)
+	_ = d.Set("created_at", rule.CreatedAt)
+	return nil
+}
+
+func resourceSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).goCMCClient()
+	_, err := client.SecurityGroup.DeleteRule(d.Id())
+	if err != nil && !errors.Is(err, gocmcapiv2.ErrNotFound) {
+		return fmt.Errorf("error delete Security Group Rule [%s]: %v", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceSecurityGroupRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return importStateWithParentId(d, meta, "security_group_id", true, resourceSecurityGroupRuleRead)
+}
+
+// api khong co get rule, lay rule tu danh sach rule cua security group
+func getSecurityGroupRule(meta interface{}, groupId string, id string) (gocmcapiv2.SecurityGroupRule, error) {
+	sg, err := getClient(meta).SecurityGroup.Get(groupId)
+	if err != nil {
+		return gocmcapiv2.SecurityGroupRule{}, err
+	}
+	for _, rule := range sg.Rules {
+		if rule.ID == id {
+			return rule, nil
+		}
+	}
+	return gocmcapiv2.SecurityGroupRule{}, fmt.Errorf("rule %s of security group %s: %w", id, groupId, gocmcapiv2.ErrNotFound)
+}
+
+func anyToString(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
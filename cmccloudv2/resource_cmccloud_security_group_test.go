@@ -0,0 +1,68 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccSecurityGroup_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("securitygroup"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityGroupConfig(f, "sg-test", true, 22),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_security_group.test", "name", "sg-test"),
+					resource.TestCheckResourceAttr("cmccloudv2_security_group.test", "stateful", "true"),
+					// rule egress mac dinh cua api da bi xoa, chi con cac rule khai bao
+					resource.TestCheckResourceAttr("cmccloudv2_security_group.test", "rule.#", "2"),
+					f.testCheckRequested("DELETE", "network/securitygroup/rule/{id}"),
+				),
+			},
+			{
+				Config: testAccSecurityGroupConfig(f, "sg-test-renamed", false, 2222),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_security_group.test", "name", "sg-test-renamed"),
+					resource.TestCheckResourceAttr("cmccloudv2_security_group.test", "stateful", "false"),
+					resource.TestCheckResourceAttr("cmccloudv2_security_group.test", "rule.#", "2"),
+				),
+			},
+			{
+				Config:            testAccSecurityGroupConfig(f, "sg-test-renamed", false, 2222),
+				ResourceName:      "cmccloudv2_security_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSecurityGroupConfig(f *fakeCMCCloudAPI, name string, stateful bool, sshPort int) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_security_group" "test" {
+  name        = %q
+  description = "security group test"
+  stateful    = %t
+
+  rule {
+    ether_type     = "IPv4"
+    direction      = "ingress"
+    protocol       = "tcp"
+    port_range_min = %d
+    port_range_max = %d
+    cidr           = "10.0.0.0/8"
+  }
+
+  rule {
+    ether_type = "IPv4"
+    direction  = "egress"
+    protocol   = "any"
+    cidr       = "0.0.0.0/0"
+  }
+}
+`, name, stateful, sshPort, sshPort)
+}
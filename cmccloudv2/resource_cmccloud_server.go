@@ -22,17 +22,29 @@ func resourceServer() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		SchemaVersion: 1,
 		Schema:        serverSchema(),
 
 		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			// subnet_id/network_interface co the chua biet khi plan (vd subnet tao cung luc), bo qua kiem tra
+			if d.NewValueKnown("subnet_id") && d.NewValueKnown("network_interface") &&
+				!isSet(d, "subnet_id") && len(d.Get("network_interface").([]interface{})) == 0 {
+				return fmt.Errorf("one of `subnet_id` or `network_interface` must be set")
+			}
+			// source_id chi duoc rebuild tai cho khi bat rebuild_on_image_change va source la image, con lai phai tao lai server
+			if d.Id() != "" && d.HasChange("source_id") && (!d.Get("rebuild_on_image_change").(bool) || d.Get("source_type").(string) != "image") {
+				if err := d.ForceNew("source_id"); err != nil {
+					return err
+				}
+			}
 			sizeOld, sizeNew := d.GetChange("volume_size")
 			if sizeOld.(int) > sizeNew.(int) {
 				return fmt.Errorf("can't shrink volume_size, new `volume_size` must be > %d", sizeOld.(int))
 			}
-			return nil
+			return customizeDiffTagsAll(d, v)
 		},
 	}
 }
@@ -45,22 +57,18 @@ func resourceServerCreate(d *schema.ResourceData, meta interface{}) error {
 		"type": d.Get("volume_type").(string),
 		"size": d.Get("volume_size").(int),
 	}
-
-	subnets := make([]map[string]interface{}, 1)
-	subnets[0] = map[string]interface{}{
-		"subnet_id": d.Get("subnet_id").(string),
+	for _, v := range d.Get("data_volume").([]interface{}) {
+		vol := v.(map[string]interface{})
+		volumes = append(volumes, map[string]interface{}{
+			"type":                  vol["type"].(string),
+			"size":                  vol["size"].(int),
+			"delete_on_termination": vol["delete_on_termination"].(bool),
+		})
 	}
-	if d.Get("ip_address").(string) != "" {
-		subnet, err := client.Subnet.Get(d.Get("subnet_id").(string))
 
-		if err != nil {
-			return fmt.Errorf("error when getting subnet info: %v", err)
-		}
-		_, err = isIpBelongToCidr(d.Get("ip_address").(string), subnet.Cidr)
-		if err != nil {
-			return err
-		}
-		subnets[0]["ip_address"] = d.Get("ip_address").(string)
+	subnets, err := buildServerSubnets(d, meta)
+	if err != nil {
+		return err
 	}
 	datas := map[string]interface{}{
 		"project":              client.Configs.ProjectId,
@@ -76,9 +84,8 @@ func resourceServerCreate(d *schema.ResourceData, meta interface{}) error {
 		"billing_mode":         d.Get("billing_mode").(string),
 		"source_type":          d.Get("source_type").(string),
 		"source_id":            d.Get("source_id").(string),
-		"tags":                 d.Get("tags").(*schema.Set).List(),
-		"subnets":              subnets, // d.Get("nics").([]interface{}),
-		// "subnets":              d.Get("nics").(*schema.Set).List(),
+		"tags":                 getTagsWithDefault(d, meta),
+		"subnets":              subnets,
 		// "eip_id":               d.Get("eip_id").(string),
 		// "domestic_bandwidth":   d.Get("domestic_bandwidth").(int),
 		// "inter_bandwidth":      d.Get("inter_bandwidth").(int),
@@ -96,11 +103,50 @@ func resourceServerCreate(d *schema.ResourceData, meta interface{}) error {
 	return readOrImport(d, meta, false)
 }
 
+// subnet_id/ip_address la cach khai bao cu cho 1 interface, network_interface cho phep khai bao nhieu interface theo thu tu
+func buildServerSubnets(d *schema.ResourceData, meta interface{}) ([]map[string]interface{}, error) {
+	nics := make([]map[string]interface{}, 0)
+	if v, ok := d.GetOk("network_interface"); ok {
+		for _, nic := range v.([]interface{}) {
+			nics = append(nics, nic.(map[string]interface{}))
+		}
+	} else {
+		nics = append(nics, map[string]interface{}{
+			"subnet_id":  d.Get("subnet_id").(string),
+			"ip_address": d.Get("ip_address").(string),
+		})
+	}
+
+	client := meta.(*CombinedConfig).goCMCClient()
+	subnets := make([]map[string]interface{}, len(nics))
+	for i, nic := range nics {
+		subnetId := nic["subnet_id"].(string)
+		subnets[i] = map[string]interface{}{
+			"subnet_id": subnetId,
+		}
+		if ip, ok := nic["ip_address"].(string); ok && ip != "" {
+			subnet, err := client.Subnet.Get(subnetId)
+			if err != nil {
+				return nil, fmt.Errorf("error when getting subnet info: %v", err)
+			}
+			_, err = isIpBelongToCidr(ip, subnet.Cidr)
+			if err != nil {
+				return nil, err
+			}
+			subnets[i]["ip_address"] = ip
+		}
+		if sgs, ok := nic["security_group_ids"].(*schema.Set); ok && sgs.Len() > 0 {
+			subnets[i]["security_group_ids"] = sgs.List()
+		}
+	}
+	return subnets, nil
+}
+
 func readOrImport(d *schema.ResourceData, meta interface{}, isImport bool) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	server, err := client.Server.Get(d.Id(), true)
 	if err != nil {
-		return fmt.Errorf("error retrieving server %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving server")
 	}
 	_ = d.Set("name", server.Name)
 	_ = d.Set("zone", server.AvailabilityZone)
@@ -111,19 +157,25 @@ func readOrImport(d *schema.ResourceData, meta interface{}, isImport bool) error
 	_ = d.Set("security_group_names", convertSecurityGroups(server.SecurityGroups))
 	_ = d.Set("ecs_group_id", strings.Join(server.ServerGroups, ","))
 	_ = d.Set("created", server.Created)
-	_ = d.Set("tags", server.Tags)
+	setTagsWithDefault(d, meta, server.Tags)
 	_ = d.Set("description", server.Description)
 	_ = d.Set("billing_mode", server.BillingMode)
 	_ = d.Set("vm_state", server.VMState)
 	_ = d.Set("volumes", convertVolumeAttachs(server.VolumesAttached))
-	if len(server.Nics) > 0 {
+	if len(d.Get("network_interface").([]interface{})) > 0 || (isImport && len(server.Nics) > 1) {
+		nics := flattenServerNetworkInterfaces(d.Get("network_interface").([]interface{}), server.Nics)
+		_ = d.Set("network_interface", nics)
+		if len(nics) > 0 {
+			_ = d.Set("interface_id", nics[0]["id"])
+		}
+	} else if len(server.Nics) > 0 && len(server.Nics[0].FixedIps) > 0 {
 		if isImport {
 			// khong set, neu set co the bi sai neu co >= 2 interfaces
 			_ = d.Set("subnet_id", server.Nics[0].FixedIps[0].SubnetID)
 		}
 
 		for _, nic := range server.Nics {
-			if nic.FixedIps[0].SubnetID == d.Get("subnet_id").(string) {
+			if len(nic.FixedIps) > 0 && nic.FixedIps[0].SubnetID == d.Get("subnet_id").(string) {
 				// chi set ngay sau khi tao server, vi khi do chua add them interface nao
 				// neu add >= 2 interfaces thi thu tu interface co the thay doi => bi doi interface_id
 				if d.Get("interface_id").(string) == "" {
@@ -137,9 +189,15 @@ func readOrImport(d *schema.ResourceData, meta interface{}, isImport bool) error
 			}
 		}
 	}
-	// if isImport {
-	// 	_ = d.Set("nics", convertNics(server.Nics))
-	// }
+	if len(d.Get("data_volume").([]interface{})) > 0 || isImport {
+		dataVolumes, err := flattenServerDataVolumes(d.Get("data_volume").([]interface{}), server.VolumesAttached, meta)
+		if err != nil {
+			return fmt.Errorf("error retrieving data volumes of server %s: %v", d.Id(), err)
+		}
+		if len(dataVolumes) > 0 || !isImport {
+			_ = d.Set("data_volume", dataVolumes)
+		}
+	}
 	if server.KeyName != "" {
 		_ = d.Set("key_name", server.KeyName)
 	}
@@ -174,8 +232,8 @@ func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
-		_, err := client.Server.SetTags(id, d.Get("tags").(*schema.Set).List())
+	if d.HasChanges("tags", "tags_all") {
+		_, err := client.Server.SetTags(id, getTagsWithDefault(d, meta))
 		if err != nil {
 			return fmt.Errorf("error when set server tags [%s]: %v", id, err)
 		}
@@ -200,9 +258,11 @@ func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("volume_size") {
-		vol, _ := client.Server.Get(d.Id(), false)
-		volumeId := vol.VolumesAttached[0].ID
-		_, err := client.Volume.Resize(volumeId, d.Get("volume_size").(int))
+		volumeId, err := getServerRootVolumeId(d.Id(), meta)
+		if err != nil {
+			return fmt.Errorf("error when resize volume of server (%s): %v", d.Id(), err)
+		}
+		_, err = client.Volume.Resize(volumeId, d.Get("volume_size").(int))
 		if err != nil {
 			return fmt.Errorf("error when resize volume of server (%s): %v", d.Id(), err)
 		}
@@ -245,6 +305,28 @@ func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("source_id") {
+		params := map[string]interface{}{
+			"image_id": d.Get("source_id").(string),
+		}
+		if v, ok := d.GetOk("password"); ok {
+			params["password"] = v.(string)
+		}
+		_, err := rebuildServer(meta, id, params)
+		if err != nil {
+			return fmt.Errorf("error when rebuild server [%s]: %v", id, err)
+		}
+		// server dang stopped thi sau khi rebuild van giu trang thai stopped
+		targetStatus := "active"
+		if oldState, _ := d.GetChange("vm_state"); oldState.(string) == "stopped" {
+			targetStatus = "stopped"
+		}
+		_, err = waitUntilServerStatusChangedState(d, meta, []string{targetStatus}, []string{"error"})
+		if err != nil {
+			return fmt.Errorf("rebuild server failed: %v", err)
+		}
+	}
+
 	if d.HasChange("vm_state") {
 		oldState, newState := d.GetChange("vm_state")
 		if oldState.(string) == "error" {
@@ -308,20 +390,141 @@ func convertVolumeAttachs(vols []gocmcapiv2.VolumeAttach) []map[string]interface
 	return result
 }
 
-func convertNics(nics []gocmcapiv2.Nic) []map[string]interface{} {
-	result := make([]map[string]interface{}, len(nics))
-	for i, nic := range nics {
-		result[i] = map[string]interface{}{
-			// "id":                 nic.Id,
-			"subnet_id":  nic.FixedIps[0].SubnetID,
-			"ip_address": nic.FixedIps[0].IPAddress,
-			// "security_group_ids": nic.SecurityGroups,
-			// "mac_address": nic.MacAddress,
+// thu tu nics tra ve tu api co the khac thu tu luc tao, nen map lai theo subnet_id/ip_address da khai bao
+func flattenServerNetworkInterfaces(configured []interface{}, nics []gocmcapiv2.Nic) []map[string]interface{} {
+	flatten := func(nic gocmcapiv2.Nic, securityGroupIds interface{}) map[string]interface{} {
+		result := map[string]interface{}{
+			"id":                 nic.ID,
+			"mac_address":        nic.MacAddress,
+			"security_group_ids": securityGroupIds,
+		}
+		if len(nic.FixedIps) > 0 {
+			result["subnet_id"] = nic.FixedIps[0].SubnetID
+			result["ip_address"] = nic.FixedIps[0].IPAddress
+		}
+		return result
+	}
+
+	if len(configured) == 0 {
+		result := make([]map[string]interface{}, len(nics))
+		for i, nic := range nics {
+			result[i] = flatten(nic, nic.SecurityGroups)
+		}
+		return result
+	}
+
+	used := make(map[string]bool)
+	result := make([]map[string]interface{}, 0, len(configured))
+	for _, c := range configured {
+		conf := c.(map[string]interface{})
+		for _, nic := range nics {
+			if used[nic.ID] || len(nic.FixedIps) == 0 || nic.FixedIps[0].SubnetID != conf["subnet_id"].(string) {
+				continue
+			}
+			if ip := conf["ip_address"].(string); ip != "" && ip != nic.FixedIps[0].IPAddress {
+				continue
+			}
+			used[nic.ID] = true
+			// giu nguyen security groups da khai bao, chi lay tu api khi chua khai bao
+			securityGroupIds := interface{}(nic.SecurityGroups)
+			if sgs, ok := conf["security_group_ids"].(*schema.Set); ok && sgs.Len() > 0 {
+				securityGroupIds = sgs
+			}
+			result = append(result, flatten(nic, securityGroupIds))
+			break
 		}
 	}
 	return result
 }
 
+// volume boot cua server la volume co bootable = true, cac volume con lai la data volume
+func getServerVolumes(attachs []gocmcapiv2.VolumeAttach, meta interface{}) (root *gocmcapiv2.Volume, datas []gocmcapiv2.Volume, err error) {
+	client := meta.(*CombinedConfig).goCMCClient()
+	for i, attach := range attachs {
+		vol, err := client.Volume.Get(attach.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		vol.DeleteOnTermination = attachs[i].DeleteOnTermination
+		if root == nil && vol.Bootable == "true" {
+			root = &vol
+			continue
+		}
+		datas = append(datas, vol)
+	}
+	return root, datas, nil
+}
+
+func getServerRootVolumeId(id string, meta interface{}) (string, error) {
+	server, err := getClient(meta).Server.Get(id, false)
+	if err != nil {
+		return "", err
+	}
+	if len(server.VolumesAttached) == 0 {
+		return "", fmt.Errorf("server %s has no volume attached", id)
+	}
+	root, _, err := getServerVolumes(server.VolumesAttached, meta)
+	if err != nil {
+		return "", err
+	}
+	if root == nil {
+		return server.VolumesAttached[0].ID, nil
+	}
+	return root.ID, nil
+}
+
+func flattenServerDataVolumes(configured []interface{}, attachs []gocmcapiv2.VolumeAttach, meta interface{}) ([]map[string]interface{}, error) {
+	_, datas, err := getServerVolumes(attachs, meta)
+	if err != nil {
+		return nil, err
+	}
+	flatten := func(vol gocmcapiv2.Volume) map[string]interface{} {
+		return map[string]interface{}{
+			"id":                    vol.ID,
+			"type":                  vol.VolumeType,
+			"size":                  vol.Size,
+			"delete_on_termination": vol.DeleteOnTermination,
+		}
+	}
+
+	result := make([]map[string]interface{}, 0)
+	if len(configured) == 0 {
+		// khi import chi lay cac volume bi xoa cung server, cac volume khac thuong duoc quan ly boi cmccloudv2_volume_attachment
+		for _, vol := range datas {
+			if vol.DeleteOnTermination {
+				result = append(result, flatten(vol))
+			}
+		}
+		return result, nil
+	}
+
+	used := make(map[string]bool)
+	for _, c := range configured {
+		conf := c.(map[string]interface{})
+		for _, vol := range datas {
+			if used[vol.ID] {
+				continue
+			}
+			if id := conf["id"].(string); id != "" {
+				if id != vol.ID {
+					continue
+				}
+			} else if vol.VolumeType != conf["type"].(string) || vol.Size != conf["size"].(int) {
+				continue
+			}
+			used[vol.ID] = true
+			v := flatten(vol)
+			// volume duoc resize ngoai terraform thi giu size da khai bao, tranh tao lai server
+			if vol.Size > conf["size"].(int) {
+				v["size"] = conf["size"].(int)
+			}
+			result = append(result, v)
+			break
+		}
+	}
+	return result, nil
+}
+
 func waitUntilServerDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	return waitUntilResourceDeleted(d, meta, WaitConf{
 		Delay:      10 * time.Second,
@@ -331,6 +534,8 @@ func waitUntilServerDeleted(d *schema.ResourceData, meta interface{}) (interface
 	})
 }
 
+// server con task_state (powering-off, resize_migrating, rebuilding...) thi van dang chuyen trang thai;
+// rieng khi rebuild vm_state giu nguyen (active/stopped) nen phai dua vao task_state de biet da rebuild xong
 func waitUntilServerStatusChangedState(d *schema.ResourceData, meta interface{}, targetStatus []string, errorStatus []string) (interface{}, error) {
 	return waitUntilResourceStatusChanged(d, meta, targetStatus, errorStatus, WaitConf{
 		Delay:      10 * time.Second,
@@ -338,6 +543,17 @@ func waitUntilServerStatusChangedState(d *schema.ResourceData, meta interface{},
 	}, func(id string) (any, error) {
 		return getClient(meta).Server.Get(id, false)
 	}, func(obj interface{}) string {
-		return obj.(gocmcapiv2.Server).VMState
+		server := obj.(gocmcapiv2.Server)
+		if taskState, ok := server.TaskState.(string); ok && taskState != "" {
+			return taskState
+		}
+		return server.VMState
 	})
 }
+
+// gocmcapiv2 chua ho tro rebuild server va CMC Cloud chua cong bo tai lieu api cho action nay; goi truc tiep
+// POST server/<id>/rebuild theo cung mau server/<id>/<action> voi resize/stop/start trong gocmcapiv2,
+// body gom image_id va password (neu co)
+func rebuildServer(meta interface{}, id string, params map[string]interface{}) (gocmcapiv2.ActionResponse, error) {
+	return getClient(meta).PerformAction("server/"+id+"/rebuild", params)
+}
@@ -48,14 +48,14 @@ func resourceServerInterfaceCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	d.SetId(inter.ID)
-	return resourceServerInterfaceRead(d, meta)
+	return readWithRetry(resourceServerInterfaceRead, d, meta)
 }
 
 func resourceServerInterfaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	inter, err := client.NetworkInterface.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Interface %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Interface")
 	}
 	// _ = d.Set("server_id", inter.ServerID)
 	_ = d.Set("subnet_id", inter.FixedIps[0].SubnetID)
@@ -78,6 +78,6 @@ func resourceServerInterfaceDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceServerInterfaceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceServerInterfaceRead(d, meta)
+	err := readWithRetry(resourceServerInterfaceRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
@@ -0,0 +1,189 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccServerFlavorSmall = "1f4e0b8a-0000-4000-8000-000000000001"
+	testAccServerFlavorLarge = "1f4e0b8a-0000-4000-8000-000000000002"
+	testAccServerImageOld    = "2a7c0d3e-0000-4000-8000-000000000001"
+	testAccServerImageNew    = "2a7c0d3e-0000-4000-8000-000000000002"
+)
+
+func TestAccServer_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("server", "volume", "subnet", "vpc", "securitygroup"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfig(f, testAccServerParams{
+					name: "server-test", flavorID: testAccServerFlavorSmall, imageID: testAccServerImageOld,
+					volumeSize: 20, securityGroup: "web", tags: `["env:test"]`, vmState: "active",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "name", "server-test"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "vm_state", "active"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "flavor_id", testAccServerFlavorSmall),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "zone", "AZ1"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "security_group_names.#", "1"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "volumes.#", "2"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "data_volume.#", "1"),
+					resource.TestCheckResourceAttrSet("cmccloudv2_server.test", "data_volume.0.id"),
+					resource.TestCheckResourceAttrSet("cmccloudv2_server.test", "interface_id"),
+					resource.TestCheckResourceAttrSet("cmccloudv2_server.test", "created"),
+				),
+			},
+			{
+				// doi ten, tags, security group, resize flavor & root volume roi tat server
+				Config: testAccServerConfig(f, testAccServerParams{
+					name: "server-test-renamed", flavorID: testAccServerFlavorLarge, imageID: testAccServerImageOld,
+					volumeSize: 30, securityGroup: "db", tags: `["env:test", "team:compute"]`, vmState: "stopped",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "name", "server-test-renamed"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "flavor_id", testAccServerFlavorLarge),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "vm_state", "stopped"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "security_group_names.#", "1"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "volume_size", "30"),
+					f.testCheckRequested("POST", "server/{id}/confirm_resize"),
+					f.testCheckRequested("POST", "server/{id}/attach_security_group"),
+					f.testCheckRequested("POST", "server/{id}/detach_security_group"),
+					f.testCheckRequested("POST", "volume/{id}/resize"),
+					f.testCheckRequested("POST", "server/{id}/stop"),
+				),
+			},
+			{
+				// rebuild tai cho voi image moi khi server dang tat, sau do bat lai server
+				Config: testAccServerConfig(f, testAccServerParams{
+					name: "server-test-renamed", flavorID: testAccServerFlavorLarge, imageID: testAccServerImageNew,
+					volumeSize: 30, securityGroup: "db", tags: `["env:test", "team:compute"]`, vmState: "active",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "source_id", testAccServerImageNew),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "vm_state", "active"),
+					f.testCheckRequested("POST", "server/{id}/rebuild"),
+					f.testCheckRequested("POST", "server/{id}/start"),
+				),
+			},
+			{
+				Config: testAccServerConfig(f, testAccServerParams{
+					name: "server-test-renamed", flavorID: testAccServerFlavorLarge, imageID: testAccServerImageNew,
+					volumeSize: 30, securityGroup: "db", tags: `["env:test", "team:compute"]`, vmState: "active",
+				}),
+				ResourceName:      "cmccloudv2_server.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// cac truong chi dung khi tao/rebuild server, api khong tra ve
+				ImportStateVerifyIgnore: []string{
+					"source_type", "source_id", "volume_type", "volume_size", "password", "user_data", "rebuild_on_image_change",
+				},
+			},
+		},
+	})
+}
+
+func TestAccServer_networkInterfaces(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	subnetA := f.seedSubnet("10.21.1.0/24")
+	subnetB := f.seedSubnet("10.21.2.0/24")
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("server", "volume"),
+		Steps: []resource.TestStep{
+			{
+				Config: f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_server" "test" {
+  name        = "server-nics-test"
+  zone        = "AZ1"
+  flavor_id   = %q
+  source_type = "image"
+  source_id   = %q
+  volume_type = "highio"
+  volume_size = 20
+
+  network_interface {
+    subnet_id = %q
+  }
+
+  network_interface {
+    subnet_id  = %q
+    ip_address = "10.21.2.100"
+  }
+}
+`, testAccServerFlavorSmall, testAccServerImageOld, subnetA, subnetB),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "network_interface.#", "2"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "network_interface.0.subnet_id", subnetA),
+					resource.TestCheckResourceAttrSet("cmccloudv2_server.test", "network_interface.0.ip_address"),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "network_interface.1.subnet_id", subnetB),
+					resource.TestCheckResourceAttr("cmccloudv2_server.test", "network_interface.1.ip_address", "10.21.2.100"),
+					resource.TestCheckResourceAttrPair("cmccloudv2_server.test", "interface_id", "cmccloudv2_server.test", "network_interface.0.id"),
+				),
+			},
+		},
+	})
+}
+
+type testAccServerParams struct {
+	name          string
+	flavorID      string
+	imageID       string
+	volumeSize    int
+	securityGroup string
+	tags          string
+	vmState       string
+}
+
+// subnet duoc tao cung server, subnet_id chua biet khi plan
+func testAccServerConfig(f *fakeCMCCloudAPI, p testAccServerParams) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_vpc" "test" {
+  name = "vpc-server-test"
+  cidr = "10.20.0.0/16"
+}
+
+resource "cmccloudv2_subnet" "test" {
+  vpc_id     = cmccloudv2_vpc.test.id
+  name       = "subnet-server-test"
+  cidr       = "10.20.1.0/24"
+  gateway_ip = "10.20.1.1"
+}
+
+resource "cmccloudv2_security_group" "web" {
+  name     = "sg-web"
+  stateful = true
+}
+
+resource "cmccloudv2_security_group" "db" {
+  name     = "sg-db"
+  stateful = true
+}
+
+resource "cmccloudv2_server" "test" {
+  name                    = %q
+  zone                    = "AZ1"
+  flavor_id               = %q
+  source_type             = "image"
+  source_id               = %q
+  rebuild_on_image_change = true
+  volume_type             = "highio"
+  volume_size             = %d
+  subnet_id               = cmccloudv2_subnet.test.id
+  security_group_names    = [cmccloudv2_security_group.%s.name]
+  password                = "Fake-Passw0rd"
+  tags                    = %s
+  vm_state                = %q
+
+  data_volume {
+    type = "commonio"
+    size = 50
+  }
+}
+`, p.name, p.flavorID, p.imageID, p.volumeSize, p.securityGroup, p.tags, p.vmState)
+}
@@ -24,6 +24,7 @@ func resourceSubnet() *schema.Resource {
 		},
 		SchemaVersion: 1,
 		Schema:        subnetSchema(),
+		CustomizeDiff: customizeDiffTagsAll,
 	}
 }
 
@@ -38,21 +39,21 @@ func resourceSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		"allocation_pools": d.Get("allocation_pools").([]interface{}),
 		"host_routes":      d.Get("host_routes").([]interface{}),
 		"dns_nameservers":  d.Get("dns_nameservers").([]interface{}),
-		"tags":             d.Get("tags").(*schema.Set).List(),
+		"tags":             getTagsWithDefault(d, meta),
 		"cidr":             d.Get("cidr").(string),
 	})
 	if err != nil {
 		return fmt.Errorf("error creating Subnet: %s", err)
 	}
 	d.SetId(subnet.ID)
-	return resourceSubnetRead(d, meta)
+	return readWithRetry(resourceSubnetRead, d, meta)
 }
 
 func resourceSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	subnet, err := client.Subnet.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Subnet %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Subnet")
 	}
 
 	_ = d.Set("id", subnet.ID)
@@ -64,7 +65,7 @@ func resourceSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	_ = d.Set("allocation_pools", convertAllocationPools(subnet.AllocationPools))
 	_ = d.Set("host_routes", convertHostRoutes(subnet.HostRoutes))
 	_ = d.Set("dns_nameservers", subnet.DNSNameservers)
-	_ = d.Set("tags", subnet.Tags)
+	setTagsWithDefault(d, meta, subnet.Tags)
 	_ = d.Set("cidr", subnet.Cidr)
 	return nil
 }
@@ -76,7 +77,7 @@ func resourceSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("cidr") || d.HasChange("vpc_id") || d.HasChange("ip_version") {
 		return errors.New("these fields 'cidr, vpc_id, ip_version' cannot be changed after creation")
 	}
-	if d.HasChange("name") || d.HasChange("enable_dhcp") || d.HasChange("gateway_ip") || d.HasChange("allocation_pools") || d.HasChange("host_routes") || d.HasChange("dns_nameservers") || d.HasChange("tags") {
+	if d.HasChange("name") || d.HasChange("enable_dhcp") || d.HasChange("gateway_ip") || d.HasChange("allocation_pools") || d.HasChange("host_routes") || d.HasChange("dns_nameservers") || d.HasChanges("tags", "tags_all") {
 		_, err := client.Subnet.Update(id, map[string]interface{}{
 			"name":             d.Get("name").(string),
 			"enable_dhcp":      d.Get("enable_dhcp").(bool),
@@ -84,14 +85,14 @@ func resourceSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 			"allocation_pools": flatternAllocationPools(d.Get("allocation_pools").([]interface{})),
 			"host_routes":      flatternHostRoutes(d.Get("host_routes").([]interface{})),
 			"dns_nameservers":  d.Get("dns_nameservers").([]interface{}),
-			"tags":             d.Get("tags").(*schema.Set).List(),
+			"tags":             getTagsWithDefault(d, meta),
 			"cidr":             d.Get("cidr").(string),
 		})
 		if err != nil {
 			return fmt.Errorf("error when rename Subnet [%s]: %v", id, err)
 		}
 	}
-	return resourceSubnetRead(d, meta)
+	return readWithRetry(resourceSubnetRead, d, meta)
 }
 
 func resourceSubnetDelete(d *schema.ResourceData, meta interface{}) error {
@@ -109,7 +110,7 @@ func resourceSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSubnetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceSubnetRead(d, meta)
+	err := readWithRetry(resourceSubnetRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
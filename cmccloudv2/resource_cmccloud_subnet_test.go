@@ -0,0 +1,77 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccSubnet_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("vpc", "subnet"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubnetConfig(f, "subnet-test", false, "10.10.1.100", `["8.8.8.8"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cmccloudv2_subnet.test", "vpc_id", "cmccloudv2_vpc.test", "id"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "name", "subnet-test"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "cidr", "10.10.1.0/24"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "ip_version", "4"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "enable_dhcp", "false"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "gateway_ip", "10.10.1.1"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "allocation_pools.0.end", "10.10.1.100"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "host_routes.0.nexthop", "10.10.1.254"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "dns_nameservers.#", "1"),
+				),
+			},
+			{
+				Config: testAccSubnetConfig(f, "subnet-test-renamed", true, "10.10.1.200", `["8.8.8.8", "1.1.1.1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "name", "subnet-test-renamed"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "enable_dhcp", "true"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "allocation_pools.0.end", "10.10.1.200"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "dns_nameservers.#", "2"),
+					resource.TestCheckResourceAttr("cmccloudv2_subnet.test", "dns_nameservers.1", "1.1.1.1"),
+				),
+			},
+			{
+				Config:            testAccSubnetConfig(f, "subnet-test-renamed", true, "10.10.1.200", `["8.8.8.8", "1.1.1.1"]`),
+				ResourceName:      "cmccloudv2_subnet.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSubnetConfig(f *fakeCMCCloudAPI, name string, enableDhcp bool, poolEnd string, dnsNameservers string) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_vpc" "test" {
+  name = "vpc-subnet-test"
+  cidr = "10.10.0.0/16"
+}
+
+resource "cmccloudv2_subnet" "test" {
+  vpc_id          = cmccloudv2_vpc.test.id
+  name            = %q
+  cidr            = "10.10.1.0/24"
+  gateway_ip      = "10.10.1.1"
+  enable_dhcp     = %t
+  dns_nameservers = %s
+  tags            = ["env:test"]
+
+  allocation_pools {
+    start = "10.10.1.10"
+    end   = %q
+  }
+
+  host_routes {
+    destination = "192.168.0.0/24"
+    nexthop     = "10.10.1.254"
+  }
+}
+`, name, enableDhcp, dnsNameservers, poolEnd)
+}
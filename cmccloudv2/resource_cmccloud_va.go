@@ -56,13 +56,13 @@ func resourceVACreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating VA: %s", err)
 	}
 	d.SetId(va.ID)
-	return resourceVARead(d, meta)
+	return readWithRetry(resourceVARead, d, meta)
 }
 
 func resourceVARead(d *schema.ResourceData, meta interface{}) error {
 	va, err := getClient(meta).VA.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving VA %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving VA")
 	}
 
 	_ = d.Set("id", va.ID)
@@ -91,7 +91,7 @@ func resourceVADelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVAImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceVARead(d, meta)
+	err := readWithRetry(resourceVARead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
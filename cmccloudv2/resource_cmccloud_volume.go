@@ -27,6 +27,7 @@ func resourceVolume() *schema.Resource {
 			customdiff.ForceNewIfChange("size", func(old, new, meta interface{}) bool {
 				return new.(int) < old.(int)
 			}),
+			customizeDiffTagsAll,
 		),
 	}
 }
@@ -40,7 +41,7 @@ func resourceVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 		"type":         d.Get("type").(string),
 		"zone_name":    d.Get("zone").(string),
 		"billing_mode": d.Get("billing_mode").(string),
-		"tags":         d.Get("tags").(*schema.Set).List(),
+		"tags":         getTagsWithDefault(d, meta),
 	})
 	if err != nil {
 		return fmt.Errorf("error creating volume: %s", err)
@@ -50,14 +51,14 @@ func resourceVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error creating volume: %s", err)
 	}
-	return resourceVolumeRead(d, meta)
+	return readWithRetry(resourceVolumeRead, d, meta)
 }
 
 func resourceVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	volume, err := client.Volume.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Volume %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Volume")
 	}
 
 	_ = d.Set("name", volume.Name)
@@ -67,7 +68,7 @@ func resourceVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	_ = d.Set("zone", volume.AvailabilityZone)
 	_ = d.Set("billing_mode", volume.BillingMode)
 	_ = d.Set("status", volume.Status)
-	_ = d.Set("tags", volume.Tags)
+	setTagsWithDefault(d, meta, interfaceToString(volume.Tags))
 	_ = d.Set("created_at", volume.CreatedAt)
 	return nil
 }
@@ -75,12 +76,12 @@ func resourceVolumeRead(d *schema.ResourceData, meta interface{}) error {
 func resourceVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	id := d.Id()
-	if d.HasChange("name") || d.HasChange("description") || d.HasChange("tags") {
+	if d.HasChange("name") || d.HasChange("description") || d.HasChanges("tags", "tags_all") {
 		// Resize Volume to new flavor
 		_, err := client.Volume.Update(id, map[string]interface{}{
 			"name":        d.Get("name").(string),
 			"description": d.Get("description").(string),
-			"tags":        d.Get("tags").(*schema.Set).List(),
+			"tags":        getTagsWithDefault(d, meta),
 		})
 		if err != nil {
 			return fmt.Errorf("error when update Volume [%s]: %v", id, err)
@@ -100,7 +101,7 @@ func resourceVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error when update billing mode of volume [%s]: %v", id, err)
 		}
 	}
-	return resourceVolumeRead(d, meta)
+	return readWithRetry(resourceVolumeRead, d, meta)
 }
 
 func resourceVolumeDelete(d *schema.ResourceData, meta interface{}) error {
@@ -118,7 +119,7 @@ func resourceVolumeDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVolumeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceVolumeRead(d, meta)
+	err := readWithRetry(resourceVolumeRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -42,7 +42,7 @@ func resourceVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error attach volume %s to server %s: %v", d.Id(), serverId, err)
 	}
-	return resourceVolumeAttachmentRead(d, meta)
+	return readWithRetry(resourceVolumeAttachmentRead, d, meta)
 }
 
 func resourceVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
@@ -50,7 +50,7 @@ func resourceVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 	volumeID := d.Id()
 	vol, err := client.Server.GetVolumeAttachmentDetail(d.Get("server_id").(string), volumeID)
 	if err != nil {
-		return fmt.Errorf("error retrieving Volume Attachment %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Volume Attachment")
 	}
 	_ = d.Set("server_id", vol.ServerID)
 	_ = d.Set("volume_id", volumeID)
@@ -75,8 +75,7 @@ func resourceVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVolumeAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceVolumeAttachmentRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "server_id", true, resourceVolumeAttachmentRead)
 }
 
 func waitUntilVolumeAttachedStateChanged(d *schema.ResourceData, meta interface{}, serverId string, pendingStatus []string, targetStatus []string) (interface{}, error) {
@@ -89,7 +88,7 @@ func waitUntilVolumeAttachedStateChanged(d *schema.ResourceData, meta interface{
 		MinTimeout:     5 * time.Second,
 		NotFoundChecks: 5,
 	}
-	return stateConf.WaitForState()
+	return waitForState(stateConf)
 }
 
 func volumeAttachedStateRefreshfunc(d *schema.ResourceData, meta interface{}, serverId string) resource.StateRefreshFunc {
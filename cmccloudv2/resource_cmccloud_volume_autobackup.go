@@ -50,14 +50,14 @@ func resourceVolumeAutoBackupCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	d.SetId(vol.ID)
 
-	return resourceVolumeAutoBackupRead(d, meta)
+	return readWithRetry(resourceVolumeAutoBackupRead, d, meta)
 }
 
 func resourceVolumeAutoBackupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	autobackup, err := client.VolumeAutoBackup.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Volume AutoBackup %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Volume AutoBackup")
 	}
 
 	_ = d.Set("name", autobackup.Name)
@@ -95,7 +95,7 @@ func resourceVolumeAutoBackupUpdate(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("error when update Volume AutoBackup [%s]: %v", id, err)
 		}
 	}
-	return resourceVolumeAutoBackupRead(d, meta)
+	return readWithRetry(resourceVolumeAutoBackupRead, d, meta)
 }
 
 func resourceVolumeAutoBackupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -113,7 +113,7 @@ func resourceVolumeAutoBackupDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVolumeAutoBackupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceVolumeAutoBackupRead(d, meta)
+	err := readWithRetry(resourceVolumeAutoBackupRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
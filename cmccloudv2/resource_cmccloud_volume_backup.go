@@ -41,14 +41,14 @@ func resourceVolumeBackupCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("error creating backup of volume [%s]: %v", d.Get("volume_id").(string), err)
 	}
-	return resourceVolumeBackupRead(d, meta)
+	return readWithRetry(resourceVolumeBackupRead, d, meta)
 }
 
 func resourceVolumeBackupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	backup, err := client.Backup.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving backup %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving backup")
 	}
 
 	// real_size := float64(backup.RealSize) / (1024 * 1024 * 1024)
@@ -76,7 +76,7 @@ func resourceVolumeBackupUpdate(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("error when rename Backup [%s]: %v", id, err)
 		}
 	}
-	return resourceVolumeBackupRead(d, meta)
+	return readWithRetry(resourceVolumeBackupRead, d, meta)
 }
 
 func resourceVolumeBackupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -94,7 +94,7 @@ func resourceVolumeBackupDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceVolumeBackupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceVolumeBackupRead(d, meta)
+	err := readWithRetry(resourceVolumeBackupRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
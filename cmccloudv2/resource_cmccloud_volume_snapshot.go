@@ -41,14 +41,14 @@ func resourceVolumeSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error creating snapshot: %s", err)
 	}
 
-	return resourceVolumeSnapshotRead(d, meta)
+	return readWithRetry(resourceVolumeSnapshotRead, d, meta)
 }
 
 func resourceVolumeSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	snapshot, err := client.Snapshot.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Snapshot %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Snapshot")
 	}
 
 	_ = d.Set("name", snapshot.Name)
@@ -71,7 +71,7 @@ func resourceVolumeSnapshotUpdate(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("error when rename volume snapshot [%s]: %v", id, err)
 		}
 	}
-	return resourceVolumeSnapshotRead(d, meta)
+	return readWithRetry(resourceVolumeSnapshotRead, d, meta)
 }
 
 func resourceVolumeSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
@@ -89,7 +89,7 @@ func resourceVolumeSnapshotDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceVolumeSnapshotImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceVolumeSnapshotRead(d, meta)
+	err := readWithRetry(resourceVolumeSnapshotRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -0,0 +1,58 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccVolume_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("volume"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeConfig(f, "volume-test", 20, "monthly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_volume.test", "name", "volume-test"),
+					resource.TestCheckResourceAttr("cmccloudv2_volume.test", "size", "20"),
+					resource.TestCheckResourceAttr("cmccloudv2_volume.test", "type", "highio"),
+					resource.TestCheckResourceAttr("cmccloudv2_volume.test", "zone", "AZ1"),
+					resource.TestCheckResourceAttr("cmccloudv2_volume.test", "status", "available"),
+					resource.TestCheckResourceAttrSet("cmccloudv2_volume.test", "created_at"),
+				),
+			},
+			{
+				Config: testAccVolumeConfig(f, "volume-test-resized", 40, "hourly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_volume.test", "name", "volume-test-resized"),
+					resource.TestCheckResourceAttr("cmccloudv2_volume.test", "size", "40"),
+					resource.TestCheckResourceAttr("cmccloudv2_volume.test", "billing_mode", "hourly"),
+					f.testCheckRequested("POST", "volume/{id}/resize"),
+				),
+			},
+			{
+				Config:            testAccVolumeConfig(f, "volume-test-resized", 40, "hourly"),
+				ResourceName:      "cmccloudv2_volume.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVolumeConfig(f *fakeCMCCloudAPI, name string, size int, billingMode string) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_volume" "test" {
+  name         = %q
+  description  = "volume test"
+  size         = %d
+  type         = "highio"
+  zone         = "AZ1"
+  billing_mode = %q
+  tags         = ["env:test"]
+}
+`, name, size, billingMode)
+}
@@ -23,6 +23,7 @@ func resourceVPC() *schema.Resource {
 		},
 		SchemaVersion: 1,
 		Schema:        vpcSchema(),
+		CustomizeDiff: customizeDiffTagsAll,
 	}
 }
 
@@ -33,20 +34,20 @@ func resourceVPCCreate(d *schema.ResourceData, meta interface{}) error {
 		"description":  d.Get("description").(string),
 		"billing_mode": d.Get("billing_mode").(string),
 		"cidr":         d.Get("cidr").(string),
-		"tags":         d.Get("tags").(*schema.Set).List(),
+		"tags":         getTagsWithDefault(d, meta),
 	})
 	if err != nil {
 		return fmt.Errorf("error creating VPC: %s", err)
 	}
 	d.SetId(vpc.ID)
-	return resourceVPCRead(d, meta)
+	return readWithRetry(resourceVPCRead, d, meta)
 }
 
 func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	vpc, err := client.VPC.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving VPC %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving VPC")
 	}
 
 	_ = d.Set("id", vpc.ID)
@@ -55,18 +56,18 @@ func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
 	_ = d.Set("description", vpc.Description)
 	_ = d.Set("billing_mode", vpc.BillingMode)
 	_ = d.Set("cidr", vpc.Cidr)
-	_ = d.Set("tags", vpc.Tags)
+	setTagsWithDefault(d, meta, interfaceToString(vpc.Tags))
 	return nil
 }
 
 func resourceVPCUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*CombinedConfig).goCMCClient()
 	id := d.Id()
-	if d.HasChange("name") || d.HasChange("description") || d.HasChange("tags") {
+	if d.HasChange("name") || d.HasChange("description") || d.HasChanges("tags", "tags_all") {
 		_, err := client.VPC.Update(id, map[string]interface{}{
 			"name":        d.Get("name").(string),
 			"description": d.Get("description").(string),
-			"tags":        d.Get("tags").(*schema.Set).List(),
+			"tags":        getTagsWithDefault(d, meta),
 		})
 		if err != nil {
 			return fmt.Errorf("error when update VPC [%s]: %v", id, err)
@@ -83,7 +84,7 @@ func resourceVPCUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error when update billing mode of VPC [%s]: %v", id, err)
 		}
 	}
-	return resourceVPCRead(d, meta)
+	return readWithRetry(resourceVPCRead, d, meta)
 }
 
 func resourceVPCDelete(d *schema.ResourceData, meta interface{}) error {
@@ -101,7 +102,7 @@ func resourceVPCDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceVPCRead(d, meta)
+	err := readWithRetry(resourceVPCRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
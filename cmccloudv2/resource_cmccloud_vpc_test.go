@@ -0,0 +1,57 @@
+package cmccloudv2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccVPC_basic(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("vpc"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConfig(f, "vpc-test", "first vpc", "monthly", `["env:test"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cmccloudv2_vpc.test", "id"),
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "name", "vpc-test"),
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "description", "first vpc"),
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "cidr", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "billing_mode", "monthly"),
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccVPCConfig(f, "vpc-test-renamed", "renamed vpc", "hourly", `["env:test", "team:network"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "name", "vpc-test-renamed"),
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "description", "renamed vpc"),
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "billing_mode", "hourly"),
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "tags.#", "2"),
+					f.testCheckRequested("PUT", "billing/update_billing_mode"),
+				),
+			},
+			{
+				Config:            testAccVPCConfig(f, "vpc-test-renamed", "renamed vpc", "hourly", `["env:test", "team:network"]`),
+				ResourceName:      "cmccloudv2_vpc.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVPCConfig(f *fakeCMCCloudAPI, name string, description string, billingMode string, tags string) string {
+	return f.providerConfig() + fmt.Sprintf(`
+resource "cmccloudv2_vpc" "test" {
+  name         = %q
+  description  = %q
+  cidr         = "10.10.0.0/16"
+  billing_mode = %q
+  tags         = %s
+}
+`, name, description, billingMode, tags)
+}
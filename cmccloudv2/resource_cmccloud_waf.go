@@ -79,13 +79,13 @@ func resourceWafCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating waf: %s", err)
 	}
 
-	return resourceWafRead(d, meta)
+	return readWithRetry(resourceWafRead, d, meta)
 }
 
 func resourceWafRead(d *schema.ResourceData, meta interface{}) error {
 	waf, err := getClient(meta).Waf.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving Waf %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving Waf")
 	}
 
 	port, err := strconv.Atoi(waf.Port)
@@ -130,7 +130,7 @@ func resourceWafUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error updating waf load balance: %s", err)
 		}
 	}
-	return resourceWafRead(d, meta)
+	return readWithRetry(resourceWafRead, d, meta)
 }
 func resourceWafDelete(d *schema.ResourceData, meta interface{}) error {
 	_, err := getClient(meta).Waf.Delete(d.Id())
@@ -146,7 +146,7 @@ func resourceWafDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWafImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceWafRead(d, meta)
+	err := readWithRetry(resourceWafRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -41,13 +41,13 @@ func resourceWafCertCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating waf cert: %s", err)
 	}
 	d.SetId(cert.ID)
-	return resourceWafCertRead(d, meta)
+	return readWithRetry(resourceWafCertRead, d, meta)
 }
 
 func resourceWafCertRead(d *schema.ResourceData, meta interface{}) error {
 	cert, err := getClient(meta).WafCert.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving waf cert %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving waf cert")
 	}
 
 	timestamp := int64(cert.Created)
@@ -81,7 +81,7 @@ func resourceWafCertDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWafCertImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceWafCertRead(d, meta)
+	err := readWithRetry(resourceWafCertRead, d, meta)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -40,16 +40,19 @@ func resourceWafIPCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating waf ip: %s", err)
 	}
 	d.SetId(ip.ID)
-	return resourceWafIPRead(d, meta)
+	return readWithRetry(resourceWafIPRead, d, meta)
 }
 
 func resourceWafIPRead(d *schema.ResourceData, meta interface{}) error {
 	ip, err := getClient(meta).WafIP.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving WafIP %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving WafIP")
 	}
 
 	_ = d.Set("id", ip.ID)
+	if ip.WafID != "" {
+		_ = d.Set("waf_id", ip.WafID)
+	}
 	_ = d.Set("type", ip.Type)
 	_ = d.Set("ip", ip.Value)
 	_ = d.Set("description", ip.Description)
@@ -71,7 +74,7 @@ func resourceWafIPUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error when update waf ip [%s]: %v", id, err)
 	}
 
-	return resourceWafIPRead(d, meta)
+	return readWithRetry(resourceWafIPRead, d, meta)
 }
 func resourceWafIPDelete(d *schema.ResourceData, meta interface{}) error {
 	_, err := getClient(meta).WafIP.Delete(d.Id())
@@ -87,8 +90,7 @@ func resourceWafIPDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWafIPImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceWafIPRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "waf_id", false, resourceWafIPRead)
 }
 
 func waitUntilWafIPDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
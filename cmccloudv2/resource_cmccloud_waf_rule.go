@@ -85,13 +85,13 @@ func resourceWafRuleCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating waf rule: %s", err)
 	}
 	d.SetId(rule.ID)
-	return resourceWafRuleRead(d, meta)
+	return readWithRetry(resourceWafRuleRead, d, meta)
 }
 
 func resourceWafRuleRead(d *schema.ResourceData, meta interface{}) error {
 	rule, err := getClient(meta).WafRule.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving waf rule %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving waf rule")
 	}
 
 	_ = d.Set("id", rule.ID)
@@ -144,7 +144,7 @@ func resourceWafRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error when update waf rule [%s]: %v", id, err)
 	}
 
-	return resourceWafRuleRead(d, meta)
+	return readWithRetry(resourceWafRuleRead, d, meta)
 }
 func resourceWafRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	_, err := getClient(meta).WafRule.Delete(d.Id())
@@ -160,8 +160,7 @@ func resourceWafRuleDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWafRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceWafRuleRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "waf_id", false, resourceWafRuleRead)
 }
 
 func waitUntilWafRuleDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -72,13 +72,13 @@ func resourceWafWhitelistCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error creating waf whitelist: %s", err)
 	}
 	d.SetId(whitelist.ID)
-	return resourceWafWhitelistRead(d, meta)
+	return readWithRetry(resourceWafWhitelistRead, d, meta)
 }
 
 func resourceWafWhitelistRead(d *schema.ResourceData, meta interface{}) error {
 	whitelist, err := getClient(meta).WafWhitelist.Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving waf whitelist %s: %v", d.Id(), err)
+		return checkDeleted(d, err, "error retrieving waf whitelist")
 	}
 
 	_ = d.Set("id", whitelist.ID)
@@ -134,7 +134,7 @@ func resourceWafWhitelistUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error when update waf whitelist [%s]: %v", id, err)
 	}
 
-	return resourceVPCRead(d, meta)
+	return readWithRetry(resourceVPCRead, d, meta)
 }
 func resourceWafWhitelistDelete(d *schema.ResourceData, meta interface{}) error {
 	_, err := getClient(meta).WafWhitelist.Delete(d.Id())
@@ -150,8 +150,7 @@ func resourceWafWhitelistDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceWafWhitelistImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	err := resourceWafWhitelistRead(d, meta)
-	return []*schema.ResourceData{d}, err
+	return importStateWithParentId(d, meta, "waf_id", false, resourceWafWhitelistRead)
 }
 
 func waitUntilWafWhitelistDeleted(d *schema.ResourceData, meta interface{}) (interface{}, error) {
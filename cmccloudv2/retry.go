@@ -0,0 +1,204 @@
+package cmccloudv2
+
+import (
+	"log"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type retryConfig struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// gocmcapiv2 tra ve loi dang "Error 503: ..." nen chi co the nhan biet ma loi qua noi dung.
+// 500 khong duoc retry vi thuong la loi nghiep vu cua backend, khong phai loi tam thoi
+var transientStatusRegexp = regexp.MustCompile(`Error (429|502|503|504)\b`)
+
+var transientErrorMessages = []string{
+	"connection reset",
+	"connection refused",
+	"broken pipe",
+	"i/o timeout",
+	"TLS handshake timeout",
+	"Client.Timeout exceeded",
+	"unexpected EOF",
+	"too many requests",
+	"service unavailable",
+	"bad gateway",
+	"gateway timeout",
+}
+
+func isTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	if transientStatusRegexp.MatchString(msg) {
+		return true
+	}
+	for _, m := range transientErrorMessages {
+		if caseInsensitiveContains(msg, m) {
+			return true
+		}
+	}
+	return strings.HasSuffix(msg, ": EOF")
+}
+
+// loi chac chan chua duoc backend xu ly: bi rate limit/qua tai (429, 503) hoac khong ket noi duoc toi api.
+// Chi nhung loi nay moi duoc retry voi Create/Update/Delete
+var notProcessedStatusRegexp = regexp.MustCompile(`Error (429|503)\b`)
+
+var notProcessedErrorMessages = []string{
+	"connection refused",
+	"no such host",
+	"dial tcp",
+	"TLS handshake timeout",
+	"too many requests",
+	"service unavailable",
+}
+
+func isNotProcessedError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	if notProcessedStatusRegexp.MatchString(msg) {
+		return true
+	}
+	for _, m := range notProcessedErrorMessages {
+		if caseInsensitiveContains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// gocmcapiv2 khong tra ve header cua response nen chi doc duoc Retry-After khi gateway dua no vao noi dung loi
+var retryAfterRegexp = regexp.MustCompile(`(?i)retry[-_ ]after"?\s*[:=]?\s*"?(\d+|[a-z]{3}, \d{2} [a-z]{3} \d{4} \d{2}:\d{2}:\d{2} gmt)`)
+
+// retryAfter tra ve thoi gian cho theo Retry-After (so giay hoac HTTP date) neu co trong loi
+func retryAfter(err error, now time.Time) (time.Duration, bool) {
+	if err == nil {
+		return 0, false
+	}
+	m := retryAfterRegexp.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0, false
+	}
+	return parseRetryAfter(m[1], now)
+}
+
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if wait := t.Sub(now); wait > 0 {
+		return wait, true
+	}
+	return 0, true
+}
+
+// backoff tra ve thoi gian cho truoc lan goi lai thu attempt; uu tien Retry-After cua loi truoc do neu co
+func (c retryConfig) backoff(attempt int, lastErr error) time.Duration {
+	if wait, ok := retryAfter(lastErr, time.Now()); ok {
+		return wait
+	}
+	wait := c.MinBackoff
+	for i := 0; i < attempt && wait < c.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > c.MaxBackoff {
+		wait = c.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// them jitter de cac resource chay song song ko goi lai api cung luc
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+func getRetryConfig(meta interface{}) retryConfig {
+	if c, ok := meta.(*CombinedConfig); ok {
+		return c.retry
+	}
+	return retryConfig{}
+}
+
+// retryOnTransientError goi lai f voi exponential backoff khi api tra ve loi 429, 502, 503, 504 hoac loi ket noi
+func retryOnTransientError(meta interface{}, f func() error) error {
+	return retryOn(meta, isTransientError, f)
+}
+
+// retryOnNotProcessedError chi goi lai f khi request truoc do chac chan chua duoc backend xu ly
+func retryOnNotProcessedError(meta interface{}, f func() error) error {
+	return retryOn(meta, isNotProcessedError, f)
+}
+
+func retryOn(meta interface{}, retryable func(error) bool, f func() error) error {
+	conf := getRetryConfig(meta)
+	err := f()
+	for attempt := 0; attempt < conf.MaxRetries && retryable(err); attempt++ {
+		wait := conf.backoff(attempt, err)
+		log.Printf("[WARN] transient error from CMC Cloud API, retry %d/%d after %s: %v", attempt+1, conf.MaxRetries, wait, err)
+		time.Sleep(wait)
+		err = f()
+	}
+	return err
+}
+
+// readWithRetry dung cho Read goi lai ben trong Create/Update/Import, vi cac ham nay goi truc tiep ham Read
+// chua duoc boc boi withRetryResource
+func readWithRetry(read schema.ReadFunc, d *schema.ResourceData, meta interface{}) error {
+	return retryOnTransientError(meta, func() error { return read(d, meta) })
+}
+
+// withRetryResources retry Read cua resource & data source voi moi loi tam thoi vi Read chi goi GET.
+// Create/Update/Delete chi duoc retry khi loi cho thay request chua duoc xu ly (429, 503, khong ket noi duoc),
+// vi POST/PUT/DELETE da duoc backend xu ly ma goi lai se tao trung resource hoac lap lai attach/rebuild/promote...
+func withRetryResources(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		withRetryResource(r)
+	}
+}
+
+func withRetryResource(r *schema.Resource) {
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			return readWithRetry(read, d, meta)
+		}
+	}
+	if create := r.Create; create != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return retryOn(meta, func(err error) bool {
+				// da co id nghia la resource da duoc tao, goi lai Create se tao trung
+				return d.Id() == "" && isNotProcessedError(err)
+			}, func() error { return create(d, meta) })
+		}
+	}
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return retryOnNotProcessedError(meta, func() error { return update(d, meta) })
+		}
+	}
+	if del := r.Delete; del != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			return retryOnNotProcessedError(meta, func() error { return del(d, meta) })
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package cmccloudv2
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	cases := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"0", 0, true},
+		{"30", 30 * time.Second, true},
+		{" 5 ", 5 * time.Second, true},
+		{"-1", 0, false},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second, true},
+		// thoi diem da qua thi goi lai ngay
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"soon", 0, false},
+		{"", 0, false},
+	}
+	for _, c := range cases {
+		got, ok := parseRetryAfter(c.value, now)
+		if got != c.want || ok != c.ok {
+			t.Errorf("parseRetryAfter(%q) = %s, %v, want %s, %v", c.value, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestRetryConfigBackoff(t *testing.T) {
+	conf := retryConfig{MaxRetries: 5, MinBackoff: time.Second, MaxBackoff: 8 * time.Second}
+	for attempt, max := range []time.Duration{1, 2, 4, 8, 8, 8} {
+		max *= time.Second
+		got := conf.backoff(attempt, errors.New("Error 502: Bad Gateway"))
+		if got < max/2 || got > max {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", attempt, got, max/2, max)
+		}
+	}
+
+	// Retry-After duoc dung thay cho exponential backoff, ke ca khi lon hon MaxBackoff
+	if got := conf.backoff(0, errors.New("Error 429: rate limit exceeded, Retry-After: 20")); got != 20*time.Second {
+		t.Errorf("backoff with Retry-After = %s, want 20s", got)
+	}
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if got := conf.backoff(0, errors.New(`Error 503: {"retry_after": "`+date+`"}`)); got < 59*time.Minute || got > time.Hour {
+		t.Errorf("backoff with Retry-After date = %s, want about 1h", got)
+	}
+}
+
+func TestIsNotProcessedError(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("Error 429: Too Many Requests"), true},
+		{errors.New("Error 503: Service Unavailable"), true},
+		{errors.New(`Post "https://api.cmccloud.vn/server": dial tcp 10.0.0.1:443: connect: connection refused`), true},
+		{errors.New(`Post "https://api.cmccloud.vn/server": dial tcp: lookup api.cmccloud.vn: no such host`), true},
+		// request co the da toi backend, goi lai POST/PUT/DELETE khong an toan
+		{errors.New("Error 502: Bad Gateway"), false},
+		{errors.New("Error 504: Gateway Timeout"), false},
+		{errors.New(`Post "https://api.cmccloud.vn/server": read tcp 10.0.0.2:5000->10.0.0.1:443: read: connection reset by peer`), false},
+		{errors.New("Error 500: internal error"), false},
+	}
+	for _, c := range cases {
+		if got := isNotProcessedError(c.err); got != c.want {
+			t.Errorf("isNotProcessedError(%v) = %v, want %v", c.err, got, c.want)
+		}
+	}
+}
+
+func TestAccVPC_retryNotProcessed(t *testing.T) {
+	f := newFakeCMCCloudAPI(t)
+	f.failNext("POST", "network/vpc", http.StatusTooManyRequests, "rate limit exceeded, retry after 0 seconds")
+	f.failNext("GET", "network/vpc/{id}", http.StatusBadGateway, "bad gateway, Retry-After: 0")
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    f.providers(),
+		CheckDestroy: f.testCheckDestroyed("vpc"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConfig(f, "vpc-retry", "retry vpc", "monthly", `["env:test"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "name", "vpc-retry"),
+					f.testCheckRequestCount("POST", "network/vpc", 2),
+					func(_ *terraform.State) error {
+						f.failNext("PUT", "network/vpc/{id}", http.StatusServiceUnavailable, "service unavailable, Retry-After: 0")
+						f.failNext("DELETE", "network/vpc/{id}", http.StatusServiceUnavailable, "service unavailable, Retry-After: 0")
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccVPCConfig(f, "vpc-retry-renamed", "retry vpc", "monthly", `["env:test"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cmccloudv2_vpc.test", "name", "vpc-retry-renamed"),
+					f.testCheckRequestCount("PUT", "network/vpc/{id}", 2),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package cmccloudv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func autoscalingAlarmSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateName,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"as_group_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+		},
+		"policy_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+			Description:  "Id of scale in/scale out policy executed when alarm fires, policy must be attached to the autoscaling group",
+		},
+		"meter": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"cpu", "memory", "network_in", "network_out"}, false),
+			Description:  "Metric to evaluate: cpu (%), memory (%), network_in/network_out (bytes per second)",
+		},
+		"comparison_operator": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"gt", "ge", "lt", "le"}, false),
+		},
+		"threshold": {
+			Type:         schema.TypeFloat,
+			Required:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+		"aggregation_method": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "mean",
+			ValidateFunc: validation.StringInSlice([]string{"mean", "max", "min"}, false),
+		},
+		"period": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntAtLeast(60),
+			Description:  "Number of seconds of each evaluation period",
+		},
+		"evaluation_periods": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Number of consecutive periods the threshold must be breached before alarm fires",
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
@@ -2,6 +2,7 @@ package cmccloudv2
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func autoscalingGroupSchema() map[string]*schema.Schema {
@@ -36,5 +37,36 @@ func autoscalingGroupSchema() map[string]*schema.Schema {
 				ValidateFunc: validateUUID,
 			},
 		},
+		"instance_refresh": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Replace existing nodes batch by batch when `as_configuration_id` changes",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"batch_size": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "Maximum number of nodes replaced in each batch",
+					},
+					"min_healthy_percentage": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      90,
+						ValidateFunc: validation.IntBetween(0, 99),
+						Description:  "Percentage of nodes that must stay running while a batch is replaced, if no node can be removed first the replacement nodes are created before old nodes are removed",
+					},
+					"pause_time": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "Number of seconds to wait between two batches",
+					},
+				},
+			},
+		},
 	}
 }
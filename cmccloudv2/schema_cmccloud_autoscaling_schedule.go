@@ -0,0 +1,69 @@
+package cmccloudv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func autoscalingScheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"as_group_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateName,
+		},
+		"cron": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateCronExpression,
+			Description:  "Cron expression with 5 fields: minute hour day-of-month month day-of-week, eg: `0 8 * * 1-5`",
+		},
+		"time_zone": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "Asia/Ho_Chi_Minh",
+			ValidateFunc: validateTimeZone,
+			Description:  "IANA time zone of cron expression",
+		},
+		"min_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      -1,
+			ValidateFunc: validation.IntAtLeast(-1),
+			Description:  "New min_size of autoscaling group, -1 (default) keeps it unchanged",
+		},
+		"max_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      -1,
+			ValidateFunc: validation.Any(validation.IntAtLeast(1), validation.IntInSlice([]int{-1})),
+			Description:  "New max_size of autoscaling group, -1 (default) keeps it unchanged",
+		},
+		"desired_capacity": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      -1,
+			ValidateFunc: validation.IntAtLeast(-1),
+			Description:  "New desired_capacity of autoscaling group, -1 (default) keeps it unchanged",
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"next_run_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
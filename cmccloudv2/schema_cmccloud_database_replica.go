@@ -0,0 +1,84 @@
+package cmccloudv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func databaseReplicaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"primary_instance_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+			Description:  "Id of the primary database instance to replicate from",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateName,
+		},
+		"flavor_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"zone": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"volume_type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"volume_size": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"subnets": {
+			Type:     schema.TypeList,
+			Required: true,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: createDatabaseInstanceSubnetsElementSchema(),
+			},
+		},
+		"billing_mode": {
+			Type:         schema.TypeString,
+			ValidateFunc: validateBillingMode,
+			Default:      "monthly",
+			Optional:     true,
+		},
+		"promote": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Detach the replica from its primary and turn it into a standalone primary instance, can not be reverted",
+		},
+		"replica_of": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Id of the instance this replica is currently replicating from, empty after promoted",
+		},
+		"datastore_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"datastore_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package cmccloudv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func databaseUserSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 32),
+		},
+		"password": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "%",
+			Description: "Host the user is allowed to connect from, % means any host",
+		},
+		"databases": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Names of databases (cmccloudv2_database_schema) the user is granted all privileges on, the backend does not support finer privilege levels",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
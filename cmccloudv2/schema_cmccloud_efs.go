@@ -51,6 +51,7 @@ func efsSchema() map[string]*schema.Schema {
 			},
 			Optional: true,
 		},
+		"tags_all": tagsAllSchema(),
 		"description": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -29,6 +29,7 @@ func eipSchema() map[string]*schema.Schema {
 			},
 			Optional: true,
 		},
+		"tags_all": tagsAllSchema(),
 		"description": {
 			Type:     schema.TypeString,
 			Optional: true,
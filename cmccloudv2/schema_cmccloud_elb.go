@@ -54,6 +54,7 @@ func elbSchema() map[string]*schema.Schema {
 			},
 			Optional: true,
 		},
+		"tags_all": tagsAllSchema(),
 		"description": {
 			Type:     schema.TypeString,
 			Optional: true,
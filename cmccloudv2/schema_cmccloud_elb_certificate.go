@@ -0,0 +1,87 @@
+package cmccloudv2
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func elbCertificateSchema() map[string]*schema.Schema {
+	trimSpaceDiff := func(k, o, n string, d *schema.ResourceData) bool {
+		return strings.TrimSpace(o) == strings.TrimSpace(n)
+	}
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateName,
+		},
+		"certificate": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "Certificate in PEM format",
+			ValidateFunc:     validatePEMCertificates,
+			DiffSuppressFunc: trimSpaceDiff,
+		},
+		"private_key": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Sensitive:        true,
+			Description:      "Private key of the certificate in PEM format",
+			DiffSuppressFunc: trimSpaceDiff,
+		},
+		"intermediates": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Description:      "Intermediate certificates chain in PEM format",
+			ValidateFunc:     validatePEMCertificates,
+			DiffSuppressFunc: trimSpaceDiff,
+		},
+		"passphrase": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+			Description: "Passphrase of the encrypted private key",
+		},
+		"container_ref": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Use as default_tls_container_ref or sni_container_refs of cmccloudv2_elb_listener",
+		},
+		"secret_ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"common_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"subject_alternative_names": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"not_before": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"expiration": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
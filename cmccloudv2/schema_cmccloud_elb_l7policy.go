@@ -0,0 +1,76 @@
+package cmccloudv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func elbL7PolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"listener_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+			Description:  "Id of the HTTP or TERMINATED_HTTPS listener",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateName,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"action": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"REDIRECT_TO_POOL", "REDIRECT_TO_URL", "REDIRECT_PREFIX", "REJECT"}, false),
+			Description:  "The L7 policy action. One of REDIRECT_TO_POOL, REDIRECT_TO_URL, REDIRECT_PREFIX or REJECT",
+		},
+		"position": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The position of this policy on the listener. Positions start at 1",
+		},
+		"redirect_pool_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateUUID,
+			Description:  "Requests matching this policy will be redirected to the pool with this ID. Only valid if action is REDIRECT_TO_POOL",
+		},
+		"redirect_url": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "Requests matching this policy will be redirected to this URL. Only valid if action is REDIRECT_TO_URL",
+		},
+		"redirect_prefix": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "Requests matching this policy will be redirected to this Prefix URL. Only valid if action is REDIRECT_PREFIX",
+		},
+		"redirect_http_code": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntInSlice([]int{301, 302, 303, 307, 308}),
+			Description:  "Requests matching this policy will be redirected to the specified URL or Prefix URL with the HTTP response code. Valid if action is REDIRECT_TO_URL or REDIRECT_PREFIX",
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"provisioning_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"operating_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
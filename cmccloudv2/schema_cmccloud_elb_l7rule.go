@@ -0,0 +1,58 @@
+package cmccloudv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func elbL7RuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"l7policy_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"HOST_NAME", "PATH", "FILE_TYPE", "HEADER", "COOKIE", "SSL_CONN_HAS_CERT", "SSL_VERIFY_RESULT", "SSL_DN_FIELD"}, false),
+			Description:  "The L7 rule type",
+		},
+		"compare_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"REGEX", "STARTS_WITH", "ENDS_WITH", "CONTAINS", "EQUAL_TO"}, false),
+			Description:  "The comparison type for the L7 rule",
+		},
+		"key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The key to use for the comparison. For example, the name of the cookie or header. Only used when type is HEADER, COOKIE or SSL_DN_FIELD",
+		},
+		"value": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "The value to use for the comparison. For example, the file type to compare",
+		},
+		"invert": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When true the logic of the rule is inverted. For example, with invert true, equal to would become not equal to",
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"provisioning_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"operating_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
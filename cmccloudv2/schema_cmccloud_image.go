@@ -0,0 +1,104 @@
+package cmccloudv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func imageSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateName,
+		},
+		"local_file_path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Path to local image file to upload, conflicts with server_id and volume_id",
+		},
+		"checksum": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			Description:  "MD5 checksum of image data, local file is verified against it before upload",
+			ValidateFunc: validateRegexp("^[0-9a-fA-F]{32}$"),
+		},
+		"server_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "Create image from this server, conflicts with local_file_path and volume_id",
+			ValidateFunc: validateUUID,
+		},
+		"volume_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "Create image from this volume, conflicts with local_file_path and server_id",
+			ValidateFunc: validateUUID,
+		},
+		"disk_format": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "qcow2",
+			ValidateFunc: validation.StringInSlice([]string{"qcow2", "raw", "vmdk", "vdi", "vhd", "iso"}, false),
+		},
+		"min_disk": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Minimum disk size (GB) required to boot from this image",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"min_ram": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Minimum ram (MB) required to boot from this image",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"visibility": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "private",
+			ValidateFunc: validation.StringInSlice([]string{"private", "shared"}, false),
+		},
+		"shared_project_ids": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Projects this image is shared with, require visibility = shared",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+		},
+		"tags_all": tagsAllSchema(),
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"size": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Size of image data in bytes",
+		},
+		"os": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package cmccloudv2
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func keypairSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"public_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "OpenSSH public key to upload, a new RSA keypair is generated if not set",
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.TrimSpace(old) == strings.TrimSpace(new)
+			},
+		},
+		"private_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "PEM encoded private key, only set when keypair is generated by this resource",
+		},
+		"fingerprint": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
@@ -26,10 +26,28 @@ func kubernetesv2Schema() map[string]*schema.Schema {
 			ValidateFunc: validation.NoZeroValues,
 		},
 		"kubernetes_version": {
-			Type:         schema.TypeString,
-			Required:     true,
-			ForceNew:     true,
-			ValidateFunc: validation.NoZeroValues,
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.NoZeroValues,
+			DiffSuppressFunc: suppressKubernetesVersionDiff,
+			Description:      "Kubernetes version of control plane, can be upgraded in place but not downgraded",
+		},
+		"rollout_strategy": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Rollout strategy of control plane nodes when upgrading",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"max_surge": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						Description:  "Number of master nodes can be created above master_count during upgrade, 0 means remove old node before creating new one",
+						ValidateFunc: validation.IntBetween(0, 1),
+					},
+				},
+			},
 		},
 		"master_count": {
 			Type:     schema.TypeInt,
@@ -99,6 +117,41 @@ func kubernetesv2Schema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"host": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Url of the kubernetes api server",
+		},
+		"kubeconfig_raw": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Admin kubeconfig of the cluster",
+		},
+		"cluster_ca_certificate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "PEM-encoded CA certificate of the cluster",
+		},
+		"client_certificate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "PEM-encoded client certificate of the admin user",
+		},
+		"client_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "PEM-encoded client key of the admin user",
+		},
+		"token": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Bearer token of the admin user, if the kubeconfig uses token authentication",
+		},
 		"created_at": {
 			Type:     schema.TypeString,
 			Computed: true,
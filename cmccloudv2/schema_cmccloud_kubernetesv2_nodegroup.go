@@ -115,17 +115,105 @@ func kubernetesv2NodeGroupSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
-		// "labels": {
-		// 	Type:     schema.TypeMap,
-		// 	Optional: true,
-		// },
-		// "taint": {
-		// 	Type:     schema.TypeMap,
-		// 	Optional: true,
-		// },
-		// "kubernetes_labels": {
-		// 	Type:     schema.TypeMap,
-		// 	Optional: true,
-		// },
+		"kubernetes_version": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressKubernetesVersionDiff,
+			Description:      "Kubernetes version of nodes, default is version of the cluster. Can be upgraded in place up to version of the cluster",
+		},
+		"rollout_strategy": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Rollout strategy of nodes when upgrading kubernetes_version",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"max_surge": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						Description:  "Number of nodes can be created above desired number of nodes during upgrade",
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"max_unavailable": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      0,
+						Description:  "Number of nodes can be unavailable during upgrade",
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Kubernetes labels applied to every node of the node group",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"taints": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Kubernetes taints applied to every node of the node group",
+			Elem: &schema.Resource{
+				Schema: createKubernetesv2NodeGroupTaintElementSchema(),
+			},
+		},
+		"kubelet_config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: createKubernetesv2NodeGroupKubeletConfigElementSchema(),
+			},
+		},
+	}
+}
+
+func createKubernetesv2NodeGroupTaintElementSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"key": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"effect": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}, false),
+		},
+	}
+}
+
+func createKubernetesv2NodeGroupKubeletConfigElementSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"max_pods": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Maximum number of pods can run on each node",
+			ValidateFunc: validation.IntBetween(10, 250),
+		},
+		"cpu_manager_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"none", "static"}, false),
+		},
+		"image_gc_high_threshold_percent": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"image_gc_low_threshold_percent": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
 	}
 }
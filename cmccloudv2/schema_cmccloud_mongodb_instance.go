@@ -72,6 +72,8 @@ func mongodbinstanceSchema() map[string]*schema.Schema {
 		"slave_count": {
 			Type:     schema.TypeInt,
 			Optional: true,
+			Computed: true,
+			ForceNew: true,
 		},
 		"security_group_ids": { // securityGroups
 			Type: schema.TypeSet,
@@ -100,6 +100,12 @@ func securityGroupSchema() map[string]*schema.Schema {
 			Type:     schema.TypeBool,
 			Required: true,
 		},
+		"manage_rules": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Set to false so this resource does not create, read or delete any rule, rules are managed by cmccloudv2_security_group_rule instead",
+		},
 		"rule": {
 			Type:     schema.TypeSet,
 			Optional: true,
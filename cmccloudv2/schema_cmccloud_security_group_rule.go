@@ -0,0 +1,25 @@
+package cmccloudv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// securityGroupStandaloneRuleSchema dung lai schema cua rule inline, api khong ho tro update rule nen moi truong deu la ForceNew
+func securityGroupStandaloneRuleSchema() map[string]*schema.Schema {
+	ruleSchema := securityGroupRuleSchema()
+	delete(ruleSchema, "id")
+	for _, s := range ruleSchema {
+		s.ForceNew = true
+	}
+	ruleSchema["security_group_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validateUUID,
+	}
+	ruleSchema["created_at"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	return ruleSchema
+}
@@ -14,22 +14,58 @@ func createServerNicsElementSchema() map[string]*schema.Schema {
 			ForceNew:     true,
 		},
 		"ip_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validateIPAddress,
+		},
+		"security_group_ids": {
+			Type: schema.TypeSet,
+			Set:  schema.HashString,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateUUID,
+			},
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mac_address": {
 			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func createServerDataVolumesElementSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:        schema.TypeString,
+			Description: "Volume type, eg: highio/commonio",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"size": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"delete_on_termination": {
+			Type:     schema.TypeBool,
 			Optional: true,
+			Default:  true,
 			ForceNew: true,
 		},
 		"id": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
-		// "security_group_ids": &schema.Schema{
-		// 	Type: schema.TypeList,
-		// 	Elem: &schema.Schema{
-		// 		Type:         schema.TypeString,
-		// 		ValidateFunc: validateUUID,
-		// 	},
-		// 	Optional: true,
-		// },
 	}
 }
 
@@ -105,9 +141,14 @@ func serverSchema() map[string]*schema.Schema {
 		"source_id": {
 			Type:         schema.TypeString,
 			Required:     true,
-			ForceNew:     true,
 			ValidateFunc: validateUUID,
 		},
+		"rebuild_on_image_change": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Rebuild server in place instead of replacing it when source_id of source_type image is changed",
+		},
 		"volume_size": {
 			Type:     schema.TypeInt,
 			Required: true,
@@ -117,28 +158,38 @@ func serverSchema() map[string]*schema.Schema {
 			Required: true,
 			ForceNew: true,
 		},
-		// "nics": {
-		// 	Type: schema.TypeList,
-		// 	// Type: schema.TypeSet,
-		// 	// Set: schema.HashResource(&schema.Resource{
-		// 	// 	Schema: createServerNicsElementSchema(),
-		// 	// }),
-		// 	Required: true,
-		// 	ForceNew: true,
-		// 	Elem: &schema.Resource{
-		// 		Schema: createServerNicsElementSchema(),
-		// 	},
-		// },
 		"subnet_id": {
-			Type:         schema.TypeString,
-			Required:     true,
-			ValidateFunc: validateUUID,
-			ForceNew:     true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  validateUUID,
+			ForceNew:      true,
+			ConflictsWith: []string{"network_interface"},
 		},
 		"ip_address": {
-			Type:     schema.TypeString,
-			Optional: true,
-			ForceNew: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"network_interface"},
+		},
+		"network_interface": {
+			Type:          schema.TypeList,
+			Description:   "Ordered list of network interfaces attached when creating server, the first one is primary interface",
+			Optional:      true,
+			ForceNew:      true,
+			MinItems:      1,
+			ConflictsWith: []string{"subnet_id", "ip_address"},
+			Elem: &schema.Resource{
+				Schema: createServerNicsElementSchema(),
+			},
+		},
+		"data_volume": {
+			Type:        schema.TypeList,
+			Description: "Extra volumes created and attached when creating server",
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Resource{
+				Schema: createServerDataVolumesElementSchema(),
+			},
 		},
 		"volumes": {
 			Type:     schema.TypeList,
@@ -162,6 +213,7 @@ func serverSchema() map[string]*schema.Schema {
 			},
 			Optional: true,
 		},
+		"tags_all": tagsAllSchema(),
 		"ecs_group_id": {
 			Type:         schema.TypeString,
 			Optional:     true,
@@ -86,6 +86,7 @@ func subnetSchema() map[string]*schema.Schema {
 			},
 			Optional: true,
 		},
+		"tags_all": tagsAllSchema(),
 		"cidr": {
 			Type:     schema.TypeString,
 			Required: true,
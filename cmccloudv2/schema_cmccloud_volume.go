@@ -49,6 +49,7 @@ func volumeSchema() map[string]*schema.Schema {
 			},
 			Optional: true,
 		},
+		"tags_all": tagsAllSchema(),
 		"status": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -30,6 +30,7 @@ func vpcSchema() map[string]*schema.Schema {
 			},
 			Optional: true,
 		},
+		"tags_all": tagsAllSchema(),
 		"cidr": {
 			Type:     schema.TypeString,
 			Required: true,
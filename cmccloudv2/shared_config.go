@@ -0,0 +1,124 @@
+package cmccloudv2
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const defaultAPIEndpoint = "https://apiv2.cloud.cmctelecom.vn"
+const defaultSharedConfigFile = "~/.cmccloud/config"
+const defaultProfile = "default"
+
+// loadSharedConfigProfile doc profile tu shared config file, ho tro 2 dang:
+//
+//	INI:                  YAML:
+//	[default]             default:
+//	api_key = xxx           api_key: xxx
+//	project_id = yyy        project_id: yyy
+//
+// file .yaml/.yml la YAML, cac file khac la INI neu dong dau tien co noi dung la [section], nguoc lai la YAML
+func loadSharedConfigProfile(path string, profile string) (map[string]string, error) {
+	path, err := expandHomeDir(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading shared config file %s: %v", path, err)
+	}
+
+	var profiles map[string]map[string]string
+	if isYAMLSharedConfig(path, data) {
+		profiles, err = parseYAMLSharedConfig(data)
+	} else {
+		profiles, err = parseINISharedConfig(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing shared config file %s: %v", path, err)
+	}
+
+	values, ok := profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %s not found in shared config file %s", profile, path)
+	}
+	return values, nil
+}
+
+func isYAMLSharedConfig(path string, data []byte) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return true
+	case ".ini", ".cfg", ".conf":
+		return false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		return !strings.HasPrefix(line, "[")
+	}
+	return false
+}
+
+func parseYAMLSharedConfig(data []byte) (map[string]map[string]string, error) {
+	var raw map[string]map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	profiles := make(map[string]map[string]string, len(raw))
+	for name, values := range raw {
+		profiles[name] = make(map[string]string, len(values))
+		for key, value := range values {
+			profiles[name][key] = fmt.Sprint(value)
+		}
+	}
+	return profiles, nil
+}
+
+func parseINISharedConfig(data []byte) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		// dung dau phan cach xuat hien truoc, vi gia tri co the chua ':' hoac '='
+		sep := strings.IndexAny(line, "=:")
+		if sep <= 0 || section == "" {
+			return nil, fmt.Errorf("invalid line %d: %s", lineNo, line)
+		}
+		if profiles[section] == nil {
+			profiles[section] = make(map[string]string)
+		}
+		profiles[section][strings.TrimSpace(line[:sep])] = strings.Trim(strings.TrimSpace(line[sep+1:]), `"'`)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func expandHomeDir(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error expanding home directory in %s: %v", path, err)
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
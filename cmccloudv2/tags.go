@@ -0,0 +1,77 @@
+package cmccloudv2
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "All tags of resource, including tags inherited from provider default_tags",
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func getDefaultTags(meta interface{}) []string {
+	if c, ok := meta.(*CombinedConfig); ok {
+		return c.defaultTags
+	}
+	return nil
+}
+
+func mergeTags(tags []string, defaultTags []string) []string {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(tags)+len(defaultTags))
+	for _, tag := range append(append([]string{}, defaultTags...), tags...) {
+		if !seen[tag] {
+			seen[tag] = true
+			result = append(result, tag)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// getTagsWithDefault tra ve tags cua resource da gop voi default_tags cua provider, dung khi create/update
+func getTagsWithDefault(d *schema.ResourceData, meta interface{}) []interface{} {
+	merged := mergeTags(getStringArrayFromTypeSet(d.Get("tags").(*schema.Set)), getDefaultTags(meta))
+	result := make([]interface{}, len(merged))
+	for i, tag := range merged {
+		result[i] = tag
+	}
+	return result
+}
+
+// setTagsWithDefault set tags_all = tags thuc te, tags = tags thuc te bo di cac tag do provider them vao
+// (tru khi tag do cung duoc khai bao trong resource) de khong bi drift
+func setTagsWithDefault(d *schema.ResourceData, meta interface{}, tags []string) {
+	configured := getStringArrayFromTypeSet(d.Get("tags").(*schema.Set))
+	defaultTags := getDefaultTags(meta)
+	own := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if arrayContains(defaultTags, tag) && !arrayContains(configured, tag) {
+			continue
+		}
+		own = append(own, tag)
+	}
+	_ = d.Set("tags", own)
+	_ = d.Set("tags_all", tags)
+}
+
+// customizeDiffTagsAll tinh lai tags_all khi tags hoac default_tags thay doi, de resource duoc update theo default_tags moi
+func customizeDiffTagsAll(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+	merged := mergeTags(getStringArrayFromTypeSet(diff.Get("tags").(*schema.Set)), getDefaultTags(meta))
+	old := getStringArrayFromTypeSet(diff.Get("tags_all").(*schema.Set))
+	if areTypeSetEqual(old, merged) {
+		return nil
+	}
+	return diff.SetNew("tags_all", merged)
+}
@@ -7,6 +7,9 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
+	// nhung zoneinfo vao binary de validateTimeZone chay giong nhau tren moi may, ke ca may khong co /usr/share/zoneinfo
+	_ "time/tzdata"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -126,6 +129,18 @@ func validateNameK8s(v interface{}, k string) (warnings []string, errors []error
 	return validateRegexp(re)(v, k)
 }
 
+// cron 5 truong: minute hour day-of-month month day-of-week
+func validateCronExpression(v interface{}, k string) (warnings []string, errors []error) {
+	re := `^\s*([0-9*,/\-]+)\s+([0-9*,/\-]+)\s+([0-9*,/\-?LW]+)\s+([0-9A-Za-z*,/\-]+)\s+([0-9A-Za-z*,/\-?L#]+)\s*$`
+	return validateRegexp(re)(v, k)
+}
+func validateTimeZone(v interface{}, k string) (warnings []string, errors []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid time zone: %v", k, err))
+	}
+	return
+}
+
 func validateFirewallID(v interface{}, k string) (warnings []string, errors []error) {
 	re := `^(allow|deny|[0-9a-fA-F]{8}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{12})$`
 	return validateRegexp(re)(v, k)
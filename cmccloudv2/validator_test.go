@@ -0,0 +1,33 @@
+package cmccloudv2
+
+import "testing"
+
+func TestValidateCronExpression(t *testing.T) {
+	valid := []string{
+		"0 8 * * 1-5",
+		"*/15 * * * *",
+		"0 0 1,15 * *",
+		"30 2 L * ?",
+		"0 9 * JAN-MAR MON#1",
+		" 0 8 * * * ",
+	}
+	for _, v := range valid {
+		if _, errs := validateCronExpression(v, "cron"); len(errs) > 0 {
+			t.Errorf("validateCronExpression(%q) returned errors %v, want none", v, errs)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"0 8 * *",
+		"0 8 * * * *",
+		"@daily",
+		"0 8 * * mon;rm",
+		"a b c d e",
+	}
+	for _, v := range invalid {
+		if _, errs := validateCronExpression(v, "cron"); len(errs) == 0 {
+			t.Errorf("validateCronExpression(%q) returned no errors, want error", v)
+		}
+	}
+}
@@ -7,6 +7,7 @@ toolchain go1.22.11
 require (
 	github.com/cmc-cloud/gocmcapiv2 v0.0.0-20240904095826-f2dd4fa796c0
 	github.com/hashicorp/terraform-plugin-sdk v1.17.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (